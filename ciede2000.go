@@ -0,0 +1,91 @@
+package munsell
+
+import "math"
+
+// deltaE2000 computes the CIEDE2000 color difference between two CIELAB
+// colors, with the weighting factors kL, kC, kH (all 1 for the reference
+// formula). See Sharma, Wu & Dalal (2005), "The CIEDE2000 Color-Difference
+// Formula: Implementation Notes, Supplementary Test Data, and Mathematical
+// Observations".
+func deltaE2000(lab1, lab2 labComponents, kL, kC, kH float64) float64 {
+	const deg2rad = math.Pi / 180
+
+	l1, a1, b1 := lab1.L, lab1.A, lab1.B
+	l2, a2, b2 := lab2.L, lab2.A, lab2.B
+
+	c1 := math.Hypot(a1, b1)
+	c2 := math.Hypot(a2, b2)
+	cBar := (c1 + c2) / 2
+
+	g := 0.5 * (1 - math.Sqrt(math.Pow(cBar, 7)/(math.Pow(cBar, 7)+math.Pow(25, 7))))
+	a1Prime := a1 * (1 + g)
+	a2Prime := a2 * (1 + g)
+
+	c1Prime := math.Hypot(a1Prime, b1)
+	c2Prime := math.Hypot(a2Prime, b2)
+
+	h1Prime := hueAngle(a1Prime, b1)
+	h2Prime := hueAngle(a2Prime, b2)
+
+	deltaLPrime := l2 - l1
+	deltaCPrime := c2Prime - c1Prime
+
+	var deltahPrime float64
+	switch {
+	case c1Prime*c2Prime == 0:
+		deltahPrime = 0
+	case math.Abs(h2Prime-h1Prime) <= 180:
+		deltahPrime = h2Prime - h1Prime
+	case h2Prime-h1Prime > 180:
+		deltahPrime = h2Prime - h1Prime - 360
+	default:
+		deltahPrime = h2Prime - h1Prime + 360
+	}
+	deltaHPrime := 2 * math.Sqrt(c1Prime*c2Prime) * math.Sin(deltahPrime/2*deg2rad)
+
+	lBarPrime := (l1 + l2) / 2
+	cBarPrime := (c1Prime + c2Prime) / 2
+
+	var hBarPrime float64
+	switch {
+	case c1Prime*c2Prime == 0:
+		hBarPrime = h1Prime + h2Prime
+	case math.Abs(h1Prime-h2Prime) <= 180:
+		hBarPrime = (h1Prime + h2Prime) / 2
+	case h1Prime+h2Prime < 360:
+		hBarPrime = (h1Prime+h2Prime)/2 + 180
+	default:
+		hBarPrime = (h1Prime+h2Prime)/2 - 180
+	}
+
+	t := 1 - 0.17*math.Cos((hBarPrime-30)*deg2rad) +
+		0.24*math.Cos(2*hBarPrime*deg2rad) +
+		0.32*math.Cos((3*hBarPrime+6)*deg2rad) -
+		0.20*math.Cos((4*hBarPrime-63)*deg2rad)
+
+	deltaTheta := 30 * math.Exp(-math.Pow((hBarPrime-275)/25, 2))
+	rC := 2 * math.Sqrt(math.Pow(cBarPrime, 7)/(math.Pow(cBarPrime, 7)+math.Pow(25, 7)))
+	sL := 1 + (0.015*math.Pow(lBarPrime-50, 2))/math.Sqrt(20+math.Pow(lBarPrime-50, 2))
+	sC := 1 + 0.045*cBarPrime
+	sH := 1 + 0.015*cBarPrime*t
+	rT := -math.Sin(2*deltaTheta*deg2rad) * rC
+
+	return math.Sqrt(
+		math.Pow(deltaLPrime/(kL*sL), 2) +
+			math.Pow(deltaCPrime/(kC*sC), 2) +
+			math.Pow(deltaHPrime/(kH*sH), 2) +
+			rT*(deltaCPrime/(kC*sC))*(deltaHPrime/(kH*sH)),
+	)
+}
+
+// hueAngle returns atan2(b, a) in degrees, normalized to [0, 360).
+func hueAngle(a, b float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	h := math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
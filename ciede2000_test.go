@@ -0,0 +1,31 @@
+package munsell
+
+import "testing"
+
+func TestDeltaE2000_Identity(t *testing.T) {
+	lab := labComponents{L: 62.3, A: -11.4, B: 27.8}
+	if got := deltaE2000(lab, lab, 1, 1, 1); got != 0 {
+		t.Errorf("deltaE2000(lab, lab) = %v, want 0", got)
+	}
+}
+
+func TestDeltaE2000_Symmetric(t *testing.T) {
+	a := labComponents{L: 50, A: 2.6772, B: -79.7751}
+	b := labComponents{L: 50, A: 0, B: -82.7485}
+	if ab, ba := deltaE2000(a, b, 1, 1, 1), deltaE2000(b, a, 1, 1, 1); ab != ba {
+		t.Errorf("deltaE2000 not symmetric: a->b = %v, b->a = %v", ab, ba)
+	}
+}
+
+// Reference pair from Sharma, Wu & Dalal (2005), "The CIEDE2000
+// Color-Difference Formula: Implementation Notes, Supplementary Test Data,
+// and Mathematical Observations", Table 1.
+func TestDeltaE2000_SharmaReferencePair(t *testing.T) {
+	a := labComponents{L: 50.0000, A: 2.6772, B: -79.7751}
+	b := labComponents{L: 50.0000, A: 0.0000, B: -82.7485}
+	got := deltaE2000(a, b, 1, 1, 1)
+	const want = 2.0425
+	if diff := got - want; diff > 0.01 || diff < -0.01 {
+		t.Errorf("deltaE2000(a, b) = %v, want ~%v", got, want)
+	}
+}
@@ -0,0 +1,104 @@
+package munsell
+
+import "math"
+
+// CIE D65 reference white, 2° observer, normalized to Y=100.
+const (
+	whiteX = 95.047
+	whiteY = 100.0
+	whiteZ = 108.883
+)
+
+// xyYComponents is the CIE 1931 chromaticity coordinates plus luminance.
+type xyYComponents struct {
+	X, Y, LargeY float64
+}
+
+// labComponents is the CIE 1976 L*a*b* color space, used here as the
+// perceptual distance metric for nearest-chip and nearest-palette lookups.
+type labComponents struct {
+	L, A, B float64
+}
+
+// srgbToLinear undoes the sRGB companding (gamma) curve on a channel in [0,1].
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// toXYZ converts sRGB (D65) to CIE XYZ, scaled so Y is in [0,100].
+func (rgb RGBComponents) toXYZ() (x, y, z float64) {
+	r := srgbToLinear(float64(rgb.Red) / 255)
+	g := srgbToLinear(float64(rgb.Green) / 255)
+	b := srgbToLinear(float64(rgb.Blue) / 255)
+
+	x = (r*0.4124564 + g*0.3575761 + b*0.1804375) * 100
+	y = (r*0.2126729 + g*0.7151522 + b*0.0721750) * 100
+	z = (r*0.0193339 + g*0.1191920 + b*0.9503041) * 100
+	return x, y, z
+}
+
+func xyzToXYY(x, y, z float64) xyYComponents {
+	sum := x + y + z
+	if sum == 0 {
+		return xyYComponents{X: 0.3127, Y: 0.3290, LargeY: 0}
+	}
+	return xyYComponents{X: x / sum, Y: y / sum, LargeY: y}
+}
+
+func xyYToXYZ(c xyYComponents) (x, y, z float64) {
+	if c.Y == 0 {
+		return 0, 0, 0
+	}
+	x = c.X * c.LargeY / c.Y
+	y = c.LargeY
+	z = (1 - c.X - c.Y) * c.LargeY / c.Y
+	return x, y, z
+}
+
+func labF(t float64) float64 {
+	if t > 0.008856 {
+		return math.Cbrt(t)
+	}
+	return (903.3*t + 16) / 116
+}
+
+func labFInverse(t float64) float64 {
+	if t3 := t * t * t; t3 > 0.008856 {
+		return t3
+	}
+	return (116*t - 16) / 903.3
+}
+
+func xyzToLab(x, y, z float64) labComponents {
+	fx := labF(x / whiteX)
+	fy := labF(y / whiteY)
+	fz := labF(z / whiteZ)
+	return labComponents{
+		L: 116*fy - 16,
+		A: 500 * (fx - fy),
+		B: 200 * (fy - fz),
+	}
+}
+
+func labToXYZ(lab labComponents) (x, y, z float64) {
+	fy := (lab.L + 16) / 116
+	fx := fy + lab.A/500
+	fz := fy - lab.B/200
+	return whiteX * labFInverse(fx), whiteY * labFInverse(fy), whiteZ * labFInverse(fz)
+}
+
+// toLab converts sRGB to CIE L*a*b* via XYZ, under the D65 reference white.
+func (rgb RGBComponents) toLab() labComponents {
+	x, y, z := rgb.toXYZ()
+	return xyzToLab(x, y, z)
+}
+
+func labDistance(a, b labComponents) float64 {
+	dl := a.L - b.L
+	da := a.A - b.A
+	db := a.B - b.B
+	return math.Sqrt(dl*dl + da*da + db*db)
+}
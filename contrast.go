@@ -0,0 +1,52 @@
+package munsell
+
+import "math"
+
+// RelativeLuminance computes the WCAG 2.1 relative luminance of rgb, the
+// basis for ContrastRatio. See
+// https://www.w3.org/TR/WCAG21/#dfn-relative-luminance
+func (rgb RGBComponents) RelativeLuminance() float64 {
+	r := wcagLinearize(float64(rgb.Red) / 255)
+	g := wcagLinearize(float64(rgb.Green) / 255)
+	b := wcagLinearize(float64(rgb.Blue) / 255)
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+func wcagLinearize(cs float64) float64 {
+	if cs <= 0.03928 {
+		return cs / 12.92
+	}
+	return math.Pow((cs+0.055)/1.055, 2.4)
+}
+
+// ContrastRatio returns the WCAG 2.1 contrast ratio between a and b, a
+// value from 1 (no contrast) to 21 (black on white).
+func ContrastRatio(a, b RGBComponents) float64 {
+	la := a.RelativeLuminance()
+	lb := b.RelativeLuminance()
+	lighter, darker := la, lb
+	if lighter < darker {
+		lighter, darker = darker, lighter
+	}
+	return (lighter + 0.05) / (darker + 0.05)
+}
+
+// MeetsWCAG_AA reports whether fg against bg meets the WCAG 2.1 level AA
+// contrast threshold: 4.5:1, or 3:1 for largeText.
+func MeetsWCAG_AA(fg, bg RGBComponents, largeText bool) bool {
+	threshold := 4.5
+	if largeText {
+		threshold = 3.0
+	}
+	return ContrastRatio(fg, bg) >= threshold
+}
+
+// MeetsWCAG_AAA reports whether fg against bg meets the WCAG 2.1 level AAA
+// contrast threshold: 7:1, or 4.5:1 for largeText.
+func MeetsWCAG_AAA(fg, bg RGBComponents, largeText bool) bool {
+	threshold := 7.0
+	if largeText {
+		threshold = 4.5
+	}
+	return ContrastRatio(fg, bg) >= threshold
+}
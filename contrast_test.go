@@ -0,0 +1,41 @@
+package munsell
+
+import "testing"
+
+func TestRelativeLuminance_BlackAndWhite(t *testing.T) {
+	white := RGBComponents{Red: 255, Green: 255, Blue: 255}
+	black := RGBComponents{Red: 0, Green: 0, Blue: 0}
+	if got := white.RelativeLuminance(); got != 1.0 {
+		t.Errorf("white.RelativeLuminance() = %v, want 1.0", got)
+	}
+	if got := black.RelativeLuminance(); got != 0.0 {
+		t.Errorf("black.RelativeLuminance() = %v, want 0.0", got)
+	}
+}
+
+func TestContrastRatio_BlackOnWhite(t *testing.T) {
+	white := RGBComponents{Red: 255, Green: 255, Blue: 255}
+	black := RGBComponents{Red: 0, Green: 0, Blue: 0}
+	// (1+0.05)/(0+0.05) = 21, the maximum possible WCAG contrast ratio.
+	if got := ContrastRatio(black, white); got != 21.0 {
+		t.Errorf("ContrastRatio(black, white) = %v, want 21.0", got)
+	}
+}
+
+func TestContrastRatio_KnownGray(t *testing.T) {
+	// #767676 on white is WebAIM's canonical "just above AA, just below
+	// AAA" reference pair, commonly cited as 4.54:1.
+	gray := RGBComponents{Red: 0x76, Green: 0x76, Blue: 0x76}
+	white := RGBComponents{Red: 255, Green: 255, Blue: 255}
+	got := ContrastRatio(gray, white)
+	const want = 4.54
+	if diff := got - want; diff > 0.01 || diff < -0.01 {
+		t.Errorf("ContrastRatio(#767676, white) = %v, want ~%v", got, want)
+	}
+	if !MeetsWCAG_AA(gray, white, false) {
+		t.Errorf("expected #767676 on white to meet WCAG AA for normal text")
+	}
+	if MeetsWCAG_AAA(gray, white, false) {
+		t.Errorf("expected #767676 on white to fail WCAG AAA for normal text")
+	}
+}
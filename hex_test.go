@@ -0,0 +1,96 @@
+package munsell
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetRGBAFromHex_Forms(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want RGBAComponents
+	}{
+		{"3-digit with hash", "#f0a", RGBAComponents{Red: 0xff, Green: 0x00, Blue: 0xaa, Alpha: 0xff}},
+		{"3-digit without hash", "f0a", RGBAComponents{Red: 0xff, Green: 0x00, Blue: 0xaa, Alpha: 0xff}},
+		{"6-digit", "#1a2b3c", RGBAComponents{Red: 0x1a, Green: 0x2b, Blue: 0x3c, Alpha: 0xff}},
+		{"6-digit uppercase", "#1A2B3C", RGBAComponents{Red: 0x1a, Green: 0x2b, Blue: 0x3c, Alpha: 0xff}},
+		{"8-digit with alpha", "#1a2b3c80", RGBAComponents{Red: 0x1a, Green: 0x2b, Blue: 0x3c, Alpha: 0x80}},
+		{"8-digit zero alpha", "#00000000", RGBAComponents{Red: 0, Green: 0, Blue: 0, Alpha: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetRGBAFromHex(tt.hex)
+			if err != nil {
+				t.Fatalf("GetRGBAFromHex(%q) returned error: %v", tt.hex, err)
+			}
+			if got != tt.want {
+				t.Errorf("GetRGBAFromHex(%q) = %+v, want %+v", tt.hex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRGBAFromHex_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		wantErr error
+	}{
+		{"empty string", "", ErrEmpty},
+		{"hash only", "#", ErrEmpty},
+		{"bad length", "#1234", ErrBadLength},
+		{"bad hex digit 3-digit", "#g0a", ErrBadHexDigit},
+		{"bad hex digit 6-digit", "#gg2b3c", ErrBadHexDigit},
+		{"bad hex digit 8-digit", "#1a2b3cgg", ErrBadHexDigit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetRGBAFromHex(tt.hex)
+			if err == nil {
+				t.Fatalf("GetRGBAFromHex(%q) returned nil error, want %v", tt.hex, tt.wantErr)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetRGBAFromHex(%q) error = %v, want wrapping %v", tt.hex, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetRGBFromHex_DiscardsAlpha(t *testing.T) {
+	got, err := GetRGBFromHex("#1a2b3c80")
+	if err != nil {
+		t.Fatalf("GetRGBFromHex returned error: %v", err)
+	}
+	want := RGBComponents{Red: 0x1a, Green: 0x2b, Blue: 0x3c}
+	if got != want {
+		t.Errorf("GetRGBFromHex = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetColorFromHex(t *testing.T) {
+	got, err := GetColorFromHex("#ff0000")
+	if err != nil {
+		t.Fatalf("GetColorFromHex returned error: %v", err)
+	}
+	if got != Red {
+		t.Errorf("GetColorFromHex(#ff0000) = %v, want Red", got)
+	}
+
+	if _, err := GetColorFromHex(""); !errors.Is(err, ErrEmpty) {
+		t.Errorf("GetColorFromHex(\"\") error = %v, want ErrEmpty", err)
+	}
+}
+
+func TestMustGetColorFromHex(t *testing.T) {
+	if got := MustGetColorFromHex("#ff0000"); got != Red {
+		t.Errorf("MustGetColorFromHex(#ff0000) = %v, want Red", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGetColorFromHex did not panic on invalid input")
+		}
+	}()
+	MustGetColorFromHex("not-a-hex-color")
+}
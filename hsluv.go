@@ -0,0 +1,213 @@
+package munsell
+
+import "math"
+
+// HSLuvComponents is the HSLuv color space: hue in degrees [0,360) as in
+// HSL, but saturation and lightness rescaled so that equal steps in S or L
+// look like equal steps to a human observer.
+type HSLuvComponents struct {
+	Hue, Saturation, Lightness float64
+}
+
+// kappa and epsilon are the CIE Lab/Luv lightness-curve constants used by
+// the HSLuv reference implementation (http://www.hsluv.org).
+const (
+	huslKappa   = 903.2962962962963
+	huslEpsilon = 0.0088564516790356308
+)
+
+// xyzToLinearRGB is the XYZ->linear-sRGB matrix, the inverse of the matrix
+// used by toXYZ. It is the basis for the gamut-boundary lines below.
+var xyzToLinearRGB = [3][3]float64{
+	{3.240969941904521, -1.537383177570093, -0.498610760293003},
+	{-0.969243636280879, 1.875967501507721, 0.041555057407175},
+	{0.055630079696993, -0.203976958888976, 1.056971514242878},
+}
+
+type huslLine struct {
+	slope, intercept float64
+}
+
+// huslBounds returns the six lines, in the (u', v') slope/intercept form
+// used by lengthOfRayUntilIntersect, that bound the sRGB gamut at
+// lightness L.
+func huslBounds(l float64) [6]huslLine {
+	var bounds [6]huslLine
+	sub1 := math.Pow(l+16, 3) / 1560896
+	sub2 := sub1
+	if sub1 <= huslEpsilon {
+		sub2 = l / huslKappa
+	}
+
+	i := 0
+	for _, row := range xyzToLinearRGB {
+		for _, t := range [2]float64{0, 1} {
+			top1 := (284517*row[0] - 94839*row[2]) * sub2
+			top2 := (838422*row[2]+769860*row[1]+731718*row[0])*l*sub2 - 769860*t*l
+			bottom := (632260*row[2]-126452*row[1])*sub2 + 126452*t
+			bounds[i] = huslLine{slope: top1 / bottom, intercept: top2 / bottom}
+			i++
+		}
+	}
+	return bounds
+}
+
+func lengthOfRayUntilIntersect(theta float64, ln huslLine) float64 {
+	return ln.intercept / (math.Sin(theta) - ln.slope*math.Cos(theta))
+}
+
+func maxChromaForLH(l, h float64) float64 {
+	hRad := h / 360 * 2 * math.Pi
+	min := math.MaxFloat64
+	for _, ln := range huslBounds(l) {
+		length := lengthOfRayUntilIntersect(hRad, ln)
+		if length >= 0 && length < min {
+			min = length
+		}
+	}
+	return min
+}
+
+func xyzToLuv(x, y, z float64) (l, u, v float64) {
+	denom := x + 15*y + 3*z
+	if denom == 0 {
+		return 0, 0, 0
+	}
+	varU := 4 * x / denom
+	varV := 9 * y / denom
+
+	l = 116*labF(y/whiteY) - 16
+	if l == 0 {
+		return 0, 0, 0
+	}
+
+	refDenom := whiteX + 15*whiteY + 3*whiteZ
+	refU := 4 * whiteX / refDenom
+	refV := 9 * whiteY / refDenom
+
+	u = 13 * l * (varU - refU)
+	v = 13 * l * (varV - refV)
+	return l, u, v
+}
+
+func luvToLCh(l, u, v float64) (lOut, c, h float64) {
+	c = math.Sqrt(u*u + v*v)
+	h = 0
+	if c >= 0.00000001 {
+		h = math.Atan2(v, u) * 180 / math.Pi
+		if h < 0 {
+			h += 360
+		}
+	}
+	return l, c, h
+}
+
+func lchToHSLuv(l, c, h float64) HSLuvComponents {
+	if l > 99.9999999 {
+		return HSLuvComponents{Hue: h, Saturation: 0, Lightness: 100}
+	}
+	if l < 0.00000001 {
+		return HSLuvComponents{Hue: h, Saturation: 0, Lightness: 0}
+	}
+	s := c / maxChromaForLH(l, h) * 100
+	return HSLuvComponents{Hue: h, Saturation: s, Lightness: l}
+}
+
+// toHSLuv converts sRGB to HSLuv via XYZ -> CIELUV -> LCh -> HSLuv, scaling
+// chroma by the maximum chroma in-gamut at that (L, H) so saturation stays
+// in [0,100] the way classic HSL saturation does.
+func (rgb RGBComponents) toHSLuv() HSLuvComponents {
+	x, y, z := rgb.toXYZ()
+	l, u, v := xyzToLuv(x, y, z)
+	return lchToHSLuv(luvToLCh(l, u, v))
+}
+
+// GetColorFromRGBHSLuv classifies rgb into the same coarse Color buckets as
+// GetColorFromRGB, but via the perceptually-uniform HSLuv space instead of
+// raw HSL. This avoids misclassifying colors that sit near a hue boundary
+// in HSL but read as the same color to a human (dark browns vs. reds, teals
+// vs. greens).
+func GetColorFromRGBHSLuv(rgb RGBComponents) Color {
+	return matchColorFromHSLuv(rgb.toHSLuv())
+}
+
+func matchColorFromHSLuv(hsl HSLuvComponents) Color {
+	l := math.Floor(hsl.Lightness)
+	s := math.Floor(hsl.Saturation)
+	h := math.Floor(hsl.Hue)
+
+	if s <= 10 && l >= 90 {
+		return White
+	} else if l <= 13 {
+		return Black
+	} else if (s <= 10 && l <= 70) || s == 0 {
+		return Black // ("Gray")
+	} else if (h >= 0 && h <= 16) || h >= 346 {
+		return Red
+	} else if h > 16 && h <= 36 {
+		return Orange
+	} else if h > 36 && h <= 64 {
+		return Yellow
+	} else if h > 64 && h <= 165 {
+		return Green
+	} else if h > 165 && h <= 208 {
+		return LightBlue
+	} else if h > 208 && h <= 260 {
+		return Blue
+	} else if h > 260 && h <= 345 {
+		return Purple
+	}
+	return Unknown
+}
+
+// ClassifierBackend selects which color space GetColor uses to bucket an
+// RGB value into a coarse Color.
+type ClassifierBackend int
+
+const (
+	// BackendHSL matches the original raw-HSL threshold classifier.
+	BackendHSL ClassifierBackend = iota
+	// BackendHSLuv classifies in perceptually-uniform HSLuv space.
+	BackendHSLuv
+	// BackendLabNearest classifies by nearest CIELAB distance to a
+	// representative color for each bucket.
+	BackendLabNearest
+)
+
+// ClassifierOptions selects the matching backend for GetColor.
+type ClassifierOptions struct {
+	Backend ClassifierBackend
+}
+
+// representativeColors gives one representative RGB swatch per coarse
+// Color bucket, used by BackendLabNearest.
+var representativeColors = map[Color]RGBComponents{
+	White:     {Red: 255, Green: 255, Blue: 255},
+	Black:     {Red: 0, Green: 0, Blue: 0},
+	Red:       {Red: 220, Green: 20, Blue: 20},
+	Orange:    {Red: 230, Green: 126, Blue: 34},
+	Yellow:    {Red: 241, Green: 196, Blue: 15},
+	Green:     {Red: 39, Green: 174, Blue: 96},
+	LightBlue: {Red: 52, Green: 190, Blue: 210},
+	Blue:      {Red: 41, Green: 88, Blue: 201},
+	Purple:    {Red: 142, Green: 68, Blue: 173},
+}
+
+func nearestRepresentativeColor(rgb RGBComponents) Color {
+	name, _ := NewCoarsePalette().Nearest(rgb)
+	return colorFromName(name)
+}
+
+// GetColor classifies rgb into a coarse Color using the backend selected by
+// opts, so callers can choose classic HSL, perceptual HSLuv, or
+// nearest-CIELAB-name matching without calling each path by name.
+func GetColor(rgb RGBComponents, opts ClassifierOptions) Color {
+	switch opts.Backend {
+	case BackendHSLuv:
+		return GetColorFromRGBHSLuv(rgb)
+	case BackendLabNearest:
+		return nearestRepresentativeColor(rgb)
+	default:
+		return GetColorFromRGB(rgb)
+	}
+}
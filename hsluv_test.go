@@ -0,0 +1,43 @@
+package munsell
+
+import "testing"
+
+// Reference values from the hsluv.org snapshot test fixtures shipped
+// alongside the reference implementation, which every language port (and
+// this one) is expected to reproduce.
+func TestToHSLuv_ReferenceColors(t *testing.T) {
+	tests := []struct {
+		name string
+		rgb  RGBComponents
+		want HSLuvComponents
+	}{
+		{"black", RGBComponents{Red: 0, Green: 0, Blue: 0}, HSLuvComponents{Hue: 0, Saturation: 0, Lightness: 0}},
+		{"white", RGBComponents{Red: 255, Green: 255, Blue: 255}, HSLuvComponents{Hue: 0, Saturation: 0, Lightness: 100}},
+		{"red", RGBComponents{Red: 255, Green: 0, Blue: 0}, HSLuvComponents{Hue: 12.177, Saturation: 100, Lightness: 53.237}},
+		{"lime", RGBComponents{Red: 0, Green: 255, Blue: 0}, HSLuvComponents{Hue: 127.715, Saturation: 100, Lightness: 87.737}},
+		{"blue", RGBComponents{Red: 0, Green: 0, Blue: 255}, HSLuvComponents{Hue: 265.874, Saturation: 100, Lightness: 32.30}},
+	}
+
+	const tolerance = 0.5
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rgb.toHSLuv()
+			if tt.want.Saturation != 0 && absFloat(got.Hue-tt.want.Hue) > tolerance {
+				t.Errorf("Hue = %v, want ~%v", got.Hue, tt.want.Hue)
+			}
+			if absFloat(got.Saturation-tt.want.Saturation) > tolerance {
+				t.Errorf("Saturation = %v, want ~%v", got.Saturation, tt.want.Saturation)
+			}
+			if absFloat(got.Lightness-tt.want.Lightness) > tolerance {
+				t.Errorf("Lightness = %v, want ~%v", got.Lightness, tt.want.Lightness)
+			}
+		})
+	}
+}
+
+func absFloat(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
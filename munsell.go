@@ -1,8 +1,10 @@
 package munsell
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"regexp"
 	"strconv"
 )
 
@@ -37,47 +39,98 @@ func (c Color) String() string {
 	return colors[c]
 }
 
-// Returns a color from Color when passed a hex color code, defaults to Unknown
-func GetColorFromHex(hexColor string) (color Color, err error) {
-	if hexColor[0] == '#' {
+// Errors returned by GetColorFromHex, GetRGBFromHex, and GetRGBAFromHex.
+var (
+	ErrEmpty       = errors.New("munsell: empty hex color")
+	ErrBadLength   = errors.New("munsell: hex color must be 3, 6, or 8 digits")
+	ErrBadHexDigit = errors.New("munsell: invalid hex digit")
+)
+
+var (
+	hex3Pattern = regexp.MustCompile(`(?i)^[0-9a-f]{3}$`)
+	hex6Pattern = regexp.MustCompile(`(?i)^[0-9a-f]{6}$`)
+	hex8Pattern = regexp.MustCompile(`(?i)^[0-9a-f]{8}$`)
+)
+
+// GetColorFromHex returns the Color bucket for a "#RGB", "#RRGGBB", or
+// "#RRGGBBAA" hex color code (the leading "#" is optional). Alpha, if
+// present, is ignored; use GetRGBAFromHex to keep it.
+func GetColorFromHex(hexColor string) (Color, error) {
+	rgb, err := GetRGBFromHex(hexColor)
+	if err != nil {
+		return Unknown, err
+	}
+	return GetColorFromRGB(rgb), nil
+}
+
+// MustGetColorFromHex is like GetColorFromHex but panics instead of
+// returning an error, for callers with a known-good constant hex string.
+func MustGetColorFromHex(hexColor string) Color {
+	color, err := GetColorFromHex(hexColor)
+	if err != nil {
+		panic(err)
+	}
+	return color
+}
+
+// GetRGBFromHex parses a "#RGB", "#RRGGBB", or "#RRGGBBAA" hex color code
+// (the leading "#" is optional) into RGBComponents, discarding any alpha
+// digits. See GetRGBAFromHex to keep them.
+func GetRGBFromHex(hexColor string) (RGBComponents, error) {
+	rgba, err := GetRGBAFromHex(hexColor)
+	if err != nil {
+		return RGBComponents{}, err
+	}
+	return RGBComponents{Red: rgba.Red, Green: rgba.Green, Blue: rgba.Blue}, nil
+}
+
+// GetRGBAFromHex parses a "#RGB", "#RRGGBB", or "#RRGGBBAA" hex color code
+// (the leading "#" is optional) into RGBAComponents. The 3- and 6-digit
+// forms produce a fully opaque color.
+func GetRGBAFromHex(hexColor string) (RGBAComponents, error) {
+	if len(hexColor) > 0 && hexColor[0] == '#' {
 		hexColor = hexColor[1:]
 	}
+	if len(hexColor) == 0 {
+		return RGBAComponents{}, ErrEmpty
+	}
+
 	switch len(hexColor) {
 	case 3:
-		// shorthand
-		rgb := getRGBFromHex(hexColor[0:1] + hexColor[0:1] + hexColor[1:2] + hexColor[1:2] + hexColor[2:3] + hexColor[2:3])
-		color = GetColorFromRGB(rgb)
+		if !hex3Pattern.MatchString(hexColor) {
+			return RGBAComponents{}, badHexDigitError(hexColor)
+		}
+		doubled := string([]byte{hexColor[0], hexColor[0], hexColor[1], hexColor[1], hexColor[2], hexColor[2]})
+		rgb := parseHex6(doubled)
+		return RGBAComponents{Red: rgb.Red, Green: rgb.Green, Blue: rgb.Blue, Alpha: 0xff}, nil
 	case 6:
-		// standard
-		rgb := getRGBFromHex(hexColor)
-		color = GetColorFromRGB(rgb)
+		if !hex6Pattern.MatchString(hexColor) {
+			return RGBAComponents{}, badHexDigitError(hexColor)
+		}
+		rgb := parseHex6(hexColor)
+		return RGBAComponents{Red: rgb.Red, Green: rgb.Green, Blue: rgb.Blue, Alpha: 0xff}, nil
 	case 8:
-		// alpha component
-		rgb := getRGBFromHex(hexColor[0:6])
-		color = GetColorFromRGB(rgb)
+		if !hex8Pattern.MatchString(hexColor) {
+			return RGBAComponents{}, badHexDigitError(hexColor)
+		}
+		rgb := parseHex6(hexColor[0:6])
+		alpha, _ := strconv.ParseUint(hexColor[6:8], 16, 8)
+		return RGBAComponents{Red: rgb.Red, Green: rgb.Green, Blue: rgb.Blue, Alpha: uint8(alpha)}, nil
 	default:
-		return Unknown, fmt.Errorf("invalid hex color code: %s", hexColor)
+		return RGBAComponents{}, fmt.Errorf("%w: %q has %d digits", ErrBadLength, hexColor, len(hexColor))
 	}
-	return color, nil
 }
 
-func ensureHexIsValid(hexColor string) bool {
-	return true
+func badHexDigitError(hexColor string) error {
+	return fmt.Errorf("%w: %q", ErrBadHexDigit, hexColor)
 }
 
-func getRGBFromHex(hexColor string) RGBComponents {
-	red, err := strconv.ParseUint(hexColor[0:2], 16, 8)
-	if err != nil {
-		panic(err)
-	}
-	green, err := strconv.ParseUint(hexColor[2:4], 16, 8)
-	if err != nil {
-		panic(err)
-	}
-	blue, err := strconv.ParseUint(hexColor[4:6], 16, 8)
-	if err != nil {
-		panic(err)
-	}
+// parseHex6 parses a 6-digit string whose digits the caller has already
+// validated as hex (via hex3Pattern, hex6Pattern or hex8Pattern).
+func parseHex6(hexColor string) RGBComponents {
+	red, _ := strconv.ParseUint(hexColor[0:2], 16, 8)
+	green, _ := strconv.ParseUint(hexColor[2:4], 16, 8)
+	blue, _ := strconv.ParseUint(hexColor[4:6], 16, 8)
 	return RGBComponents{
 		Red:   uint8(red),
 		Green: uint8(green),
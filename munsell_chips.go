@@ -0,0 +1,531 @@
+package munsell
+
+// munsellChip is one entry of the chip table consulted by NotationFromRGB:
+// a Munsell hue/value/chroma coordinate alongside a CIE xyY chromaticity for
+// it.
+//
+// IMPORTANT: unlike the published Munsell Renotation (Newhall, Nickerson &
+// Judd), the table below is NOT measured chip data. It is a synthetically
+// generated placeholder: every hue x huePrefix x value x chroma combination
+// is present, which the real renotation never is (high-value/high-chroma
+// combinations fall outside the surface-color gamut and are simply absent
+// from the published tables). Treat conversions through this table as
+// illustrative only, not as accurate Munsell notations; the x, y columns
+// are an analytic approximation and the Y column is the real reflectance
+// implied by the ASTM/Munsell Value function (see munsellValueToY), not an
+// independently measured luminance.
+//
+// NotationFromRGB/ToMunsell are therefore not yet the accurate Munsell
+// conversion the package name implies. Call SetRenotationChips with real
+// chip data (parsed with ParseRenotationData from the standard
+// "real.dat"/"all.dat" renotation files) before depending on them for
+// anything beyond a rough approximation; see renotation.go.
+type munsellChip struct {
+	hueLetter string
+	huePrefix float64
+	value     float64
+	chroma    float64
+	x, y, Y   float64
+}
+
+// munsellValueToY converts a Munsell Value V (0-10) to the CIE luminance
+// factor Y (0-100) it corresponds to for a matte surface, via the
+// ASTM D1535 / Newhall-Nickerson-Judd polynomial:
+//
+//	Y = 1.2219V - 0.23111V^2 + 0.23951V^3 - 0.021009V^4 + 0.0008404V^5
+//
+// This is the accepted relationship and is NOT the same as the common but
+// inaccurate rule of thumb L* = 10V run backwards through the Lab cube
+// root; that approximation undershoots Y by several percent across the
+// mid range (e.g. V=5 gives Y=19.77 here, not a rounder number).
+func munsellValueToY(v float64) float64 {
+	return 1.2219*v - 0.23111*v*v + 0.23951*v*v*v - 0.021009*v*v*v*v + 0.0008404*v*v*v*v*v
+}
+
+var munsellChips = []munsellChip{
+	{"R", 2.5, 2, 2, 0.34482, 0.30148, munsellValueToY(2)},
+	{"R", 2.5, 2, 6, 0.40539, 0.25249, munsellValueToY(2)},
+	{"R", 2.5, 2, 12, 0.48520, 0.19344, munsellValueToY(2)},
+	{"R", 2.5, 4, 2, 0.33347, 0.31108, munsellValueToY(4)},
+	{"R", 2.5, 4, 6, 0.37360, 0.27773, munsellValueToY(4)},
+	{"R", 2.5, 4, 12, 0.42961, 0.23393, munsellValueToY(4)},
+	{"R", 2.5, 6, 2, 0.32805, 0.31572, munsellValueToY(6)},
+	{"R", 2.5, 6, 6, 0.35800, 0.29049, munsellValueToY(6)},
+	{"R", 2.5, 6, 12, 0.40081, 0.25607, munsellValueToY(6)},
+	{"R", 2.5, 8, 2, 0.32488, 0.31845, munsellValueToY(8)},
+	{"R", 2.5, 8, 6, 0.34875, 0.29818, munsellValueToY(8)},
+	{"R", 2.5, 8, 12, 0.38331, 0.26991, munsellValueToY(8)},
+	{"R", 5, 2, 2, 0.35433, 0.30910, munsellValueToY(2)},
+	{"R", 5, 2, 6, 0.43606, 0.26998, munsellValueToY(2)},
+	{"R", 5, 2, 12, 0.54818, 0.21630, munsellValueToY(2)},
+	{"R", 5, 4, 2, 0.33948, 0.31622, munsellValueToY(4)},
+	{"R", 5, 4, 6, 0.39270, 0.29073, munsellValueToY(4)},
+	{"R", 5, 4, 12, 0.46969, 0.25388, munsellValueToY(4)},
+	{"R", 5, 6, 2, 0.33243, 0.31959, munsellValueToY(6)},
+	{"R", 5, 6, 6, 0.37179, 0.30075, munsellValueToY(6)},
+	{"R", 5, 6, 12, 0.42976, 0.27300, munsellValueToY(6)},
+	{"R", 5, 8, 2, 0.32833, 0.32156, munsellValueToY(8)},
+	{"R", 5, 8, 6, 0.35953, 0.30662, munsellValueToY(8)},
+	{"R", 5, 8, 12, 0.40584, 0.28445, munsellValueToY(8)},
+	{"R", 7.5, 2, 2, 0.36283, 0.31723, munsellValueToY(2)},
+	{"R", 7.5, 2, 6, 0.46328, 0.28855, munsellValueToY(2)},
+	{"R", 7.5, 2, 12, 0.60092, 0.23957, munsellValueToY(2)},
+	{"R", 7.5, 4, 2, 0.34483, 0.32167, munsellValueToY(4)},
+	{"R", 7.5, 4, 6, 0.40977, 0.30461, munsellValueToY(4)},
+	{"R", 7.5, 4, 12, 0.50485, 0.27491, munsellValueToY(4)},
+	{"R", 7.5, 6, 2, 0.33634, 0.32369, munsellValueToY(6)},
+	{"R", 7.5, 6, 6, 0.38412, 0.31168, munsellValueToY(6)},
+	{"R", 7.5, 6, 12, 0.45548, 0.29100, munsellValueToY(6)},
+	{"R", 7.5, 8, 2, 0.33139, 0.32484, munsellValueToY(8)},
+	{"R", 7.5, 8, 6, 0.36915, 0.31561, munsellValueToY(8)},
+	{"R", 7.5, 8, 12, 0.42595, 0.29994, munsellValueToY(8)},
+	{"R", 10, 2, 2, 0.37005, 0.32570, munsellValueToY(2)},
+	{"R", 10, 2, 6, 0.48566, 0.30795, munsellValueToY(2)},
+	{"R", 10, 2, 12, 0.63873, 0.26264, munsellValueToY(2)},
+	{"R", 10, 4, 2, 0.34937, 0.32734, munsellValueToY(4)},
+	{"R", 10, 4, 6, 0.42413, 0.31916, munsellValueToY(4)},
+	{"R", 10, 4, 12, 0.53296, 0.29667, munsellValueToY(4)},
+	{"R", 10, 6, 2, 0.33965, 0.32795, munsellValueToY(6)},
+	{"R", 10, 6, 6, 0.39456, 0.32313, munsellValueToY(6)},
+	{"R", 10, 6, 12, 0.47673, 0.30982, munsellValueToY(6)},
+	{"R", 10, 8, 2, 0.33400, 0.32825, munsellValueToY(8)},
+	{"R", 10, 8, 6, 0.37731, 0.32500, munsellValueToY(8)},
+	{"R", 10, 8, 12, 0.44278, 0.31618, munsellValueToY(8)},
+	{"YR", 2.5, 2, 2, 0.37575, 0.33437, munsellValueToY(2)},
+	{"YR", 2.5, 2, 6, 0.50218, 0.32798, munsellValueToY(2)},
+	{"YR", 2.5, 2, 12, 0.66392, 0.28739, munsellValueToY(2)},
+	{"YR", 2.5, 4, 2, 0.35298, 0.33311, munsellValueToY(4)},
+	{"YR", 2.5, 4, 6, 0.43525, 0.33417, munsellValueToY(4)},
+	{"YR", 2.5, 4, 12, 0.55253, 0.31898, munsellValueToY(4)},
+	{"YR", 2.5, 6, 2, 0.34228, 0.33226, munsellValueToY(6)},
+	{"YR", 2.5, 6, 6, 0.40276, 0.33489, munsellValueToY(6)},
+	{"YR", 2.5, 6, 12, 0.49254, 0.32927, munsellValueToY(6)},
+	{"YR", 2.5, 8, 2, 0.33607, 0.33169, munsellValueToY(8)},
+	{"YR", 2.5, 8, 6, 0.38376, 0.33463, munsellValueToY(8)},
+	{"YR", 2.5, 8, 12, 0.45566, 0.33296, munsellValueToY(8)},
+	{"YR", 5, 2, 2, 0.37978, 0.34307, munsellValueToY(2)},
+	{"YR", 5, 2, 6, 0.51226, 0.34851, munsellValueToY(2)},
+	{"YR", 5, 2, 12, 0.68660, 0.31926, munsellValueToY(2)},
+	{"YR", 5, 4, 2, 0.35555, 0.33886, munsellValueToY(4)},
+	{"YR", 5, 4, 6, 0.44276, 0.34946, munsellValueToY(4)},
+	{"YR", 5, 4, 12, 0.56298, 0.34184, munsellValueToY(4)},
+	{"YR", 5, 6, 2, 0.34416, 0.33654, munsellValueToY(6)},
+	{"YR", 5, 6, 6, 0.40845, 0.34680, munsellValueToY(6)},
+	{"YR", 5, 6, 12, 0.50238, 0.34919, munsellValueToY(6)},
+	{"YR", 5, 8, 2, 0.33755, 0.33510, munsellValueToY(8)},
+	{"YR", 5, 8, 6, 0.38829, 0.34432, munsellValueToY(8)},
+	{"YR", 5, 8, 12, 0.46414, 0.35010, munsellValueToY(8)},
+	{"YR", 7.5, 2, 2, 0.38202, 0.35163, munsellValueToY(2)},
+	{"YR", 7.5, 2, 6, 0.51582, 0.36950, munsellValueToY(2)},
+	{"YR", 7.5, 2, 12, 0.70679, 0.36012, munsellValueToY(2)},
+	{"YR", 7.5, 4, 2, 0.35701, 0.34445, munsellValueToY(4)},
+	{"YR", 7.5, 4, 6, 0.44645, 0.36483, munsellValueToY(4)},
+	{"YR", 7.5, 4, 12, 0.56451, 0.36540, munsellValueToY(4)},
+	{"YR", 7.5, 6, 2, 0.34524, 0.34069, munsellValueToY(6)},
+	{"YR", 7.5, 6, 6, 0.41147, 0.35864, munsellValueToY(6)},
+	{"YR", 7.5, 6, 12, 0.50609, 0.36950, munsellValueToY(6)},
+	{"YR", 7.5, 8, 2, 0.33840, 0.33839, munsellValueToY(8)},
+	{"YR", 7.5, 8, 6, 0.39078, 0.35388, munsellValueToY(8)},
+	{"YR", 7.5, 8, 12, 0.46803, 0.36742, munsellValueToY(8)},
+	{"YR", 10, 2, 2, 0.38241, 0.35987, munsellValueToY(2)},
+	{"YR", 10, 2, 6, 0.51447, 0.39191, munsellValueToY(2)},
+	{"YR", 10, 2, 12, 0.72358, 0.41183, munsellValueToY(2)},
+	{"YR", 10, 4, 2, 0.35733, 0.34977, munsellValueToY(4)},
+	{"YR", 10, 4, 6, 0.44632, 0.38006, munsellValueToY(4)},
+	{"YR", 10, 4, 12, 0.55791, 0.38990, munsellValueToY(4)},
+	{"YR", 10, 6, 2, 0.34548, 0.34460, munsellValueToY(6)},
+	{"YR", 10, 6, 6, 0.41179, 0.37019, munsellValueToY(6)},
+	{"YR", 10, 6, 12, 0.50390, 0.39011, munsellValueToY(6)},
+	{"YR", 10, 8, 2, 0.33860, 0.34148, munsellValueToY(8)},
+	{"YR", 10, 8, 6, 0.39117, 0.36312, munsellValueToY(8)},
+	{"YR", 10, 8, 12, 0.46738, 0.38474, munsellValueToY(8)},
+	{"Y", 2.5, 2, 2, 0.38096, 0.36760, munsellValueToY(2)},
+	{"Y", 2.5, 2, 6, 0.50874, 0.41586, munsellValueToY(2)},
+	{"Y", 2.5, 2, 12, 0.73520, 0.47616, munsellValueToY(2)},
+	{"Y", 2.5, 4, 2, 0.35649, 0.35468, munsellValueToY(4)},
+	{"Y", 2.5, 4, 6, 0.44251, 0.39489, munsellValueToY(4)},
+	{"Y", 2.5, 4, 12, 0.54428, 0.41550, munsellValueToY(4)},
+	{"Y", 2.5, 6, 2, 0.34489, 0.34819, munsellValueToY(6)},
+	{"Y", 2.5, 6, 6, 0.40943, 0.38122, munsellValueToY(6)},
+	{"Y", 2.5, 6, 12, 0.49628, 0.41089, munsellValueToY(6)},
+	{"Y", 2.5, 8, 2, 0.33814, 0.34431, munsellValueToY(8)},
+	{"Y", 2.5, 8, 6, 0.38948, 0.37184, munsellValueToY(8)},
+	{"Y", 2.5, 8, 12, 0.46242, 0.40182, munsellValueToY(8)},
+	{"Y", 5, 2, 2, 0.37775, 0.37460, munsellValueToY(2)},
+	{"Y", 5, 2, 6, 0.49824, 0.44045, munsellValueToY(2)},
+	{"Y", 5, 2, 12, 0.73864, 0.55391, munsellValueToY(2)},
+	{"Y", 5, 4, 2, 0.35454, 0.35904, munsellValueToY(4)},
+	{"Y", 5, 4, 6, 0.43528, 0.40901, munsellValueToY(4)},
+	{"Y", 5, 4, 12, 0.52495, 0.44234, munsellValueToY(4)},
+	{"Y", 5, 6, 2, 0.34349, 0.35135, munsellValueToY(6)},
+	{"Y", 5, 6, 6, 0.40454, 0.39144, munsellValueToY(6)},
+	{"Y", 5, 6, 12, 0.48388, 0.43158, munsellValueToY(6)},
+	{"Y", 5, 8, 2, 0.33705, 0.34678, munsellValueToY(8)},
+	{"Y", 5, 8, 6, 0.38579, 0.37979, munsellValueToY(8)},
+	{"Y", 5, 8, 12, 0.45351, 0.41835, munsellValueToY(8)},
+	{"Y", 7.5, 2, 2, 0.37288, 0.38068, munsellValueToY(2)},
+	{"Y", 7.5, 2, 6, 0.48278, 0.46450, munsellValueToY(2)},
+	{"Y", 7.5, 2, 12, 0.72945, 0.64349, munsellValueToY(2)},
+	{"Y", 7.5, 4, 2, 0.35152, 0.36274, munsellValueToY(4)},
+	{"Y", 7.5, 4, 6, 0.42497, 0.42202, munsellValueToY(4)},
+	{"Y", 7.5, 4, 12, 0.50127, 0.47036, munsellValueToY(4)},
+	{"Y", 7.5, 6, 2, 0.34131, 0.35400, munsellValueToY(6)},
+	{"Y", 7.5, 6, 6, 0.39731, 0.40056, munsellValueToY(6)},
+	{"Y", 7.5, 6, 12, 0.46738, 0.45178, munsellValueToY(6)},
+	{"Y", 7.5, 8, 2, 0.33534, 0.34885, munsellValueToY(8)},
+	{"Y", 7.5, 8, 6, 0.38024, 0.38675, munsellValueToY(8)},
+	{"Y", 7.5, 8, 12, 0.44111, 0.43390, munsellValueToY(8)},
+	{"Y", 10, 2, 2, 0.36651, 0.38561, munsellValueToY(2)},
+	{"Y", 10, 2, 6, 0.46244, 0.48654, munsellValueToY(2)},
+	{"Y", 10, 2, 12, 0.70216, 0.73875, munsellValueToY(2)},
+	{"Y", 10, 4, 2, 0.34754, 0.36566, munsellValueToY(4)},
+	{"Y", 10, 4, 6, 0.41198, 0.43345, munsellValueToY(4)},
+	{"Y", 10, 4, 12, 0.47377, 0.49846, munsellValueToY(4)},
+	{"Y", 10, 6, 2, 0.33842, 0.35605, munsellValueToY(6)},
+	{"Y", 10, 6, 6, 0.38801, 0.40823, munsellValueToY(6)},
+	{"Y", 10, 6, 12, 0.44750, 0.47082, munsellValueToY(6)},
+	{"Y", 10, 8, 2, 0.33307, 0.35043, munsellValueToY(8)},
+	{"Y", 10, 8, 6, 0.37301, 0.39245, munsellValueToY(8)},
+	{"Y", 10, 8, 12, 0.42573, 0.44791, munsellValueToY(8)},
+	{"GY", 2.5, 2, 2, 0.35885, 0.38920, munsellValueToY(2)},
+	{"GY", 2.5, 2, 6, 0.43772, 0.50497, munsellValueToY(2)},
+	{"GY", 2.5, 2, 12, 0.65214, 0.82736, munsellValueToY(2)},
+	{"GY", 2.5, 4, 2, 0.34271, 0.36767, munsellValueToY(4)},
+	{"GY", 2.5, 4, 6, 0.39674, 0.44274, munsellValueToY(4)},
+	{"GY", 2.5, 4, 12, 0.44316, 0.52517, munsellValueToY(4)},
+	{"GY", 2.5, 6, 2, 0.33490, 0.35745, munsellValueToY(6)},
+	{"GY", 2.5, 6, 6, 0.37692, 0.41411, munsellValueToY(6)},
+	{"GY", 2.5, 6, 12, 0.42492, 0.48780, munsellValueToY(6)},
+	{"GY", 2.5, 8, 2, 0.33030, 0.35149, munsellValueToY(8)},
+	{"GY", 2.5, 8, 6, 0.36434, 0.39667, munsellValueToY(8)},
+	{"GY", 2.5, 8, 12, 0.40787, 0.45970, munsellValueToY(8)},
+	{"GY", 5, 2, 2, 0.35010, 0.39125, munsellValueToY(2)},
+	{"GY", 5, 2, 6, 0.40953, 0.51833, munsellValueToY(2)},
+	{"GY", 5, 2, 12, 0.57897, 0.89230, munsellValueToY(2)},
+	{"GY", 5, 4, 2, 0.33716, 0.36871, munsellValueToY(4)},
+	{"GY", 5, 4, 6, 0.37969, 0.44927, munsellValueToY(4)},
+	{"GY", 5, 4, 12, 0.41049, 0.54891, munsellValueToY(4)},
+	{"GY", 5, 6, 2, 0.33084, 0.35812, munsellValueToY(6)},
+	{"GY", 5, 6, 6, 0.36437, 0.41784, munsellValueToY(6)},
+	{"GY", 5, 6, 12, 0.40024, 0.50152, munsellValueToY(6)},
+	{"GY", 5, 8, 2, 0.32711, 0.35198, munsellValueToY(8)},
+	{"GY", 5, 8, 6, 0.35446, 0.39916, munsellValueToY(8)},
+	{"GY", 5, 8, 12, 0.38805, 0.46846, munsellValueToY(8)},
+	{"GY", 7.5, 2, 2, 0.34050, 0.39162, munsellValueToY(2)},
+	{"GY", 7.5, 2, 6, 0.37918, 0.52553, munsellValueToY(2)},
+	{"GY", 7.5, 2, 12, 0.48922, 0.91847, munsellValueToY(2)},
+	{"GY", 7.5, 4, 2, 0.33104, 0.36871, munsellValueToY(4)},
+	{"GY", 7.5, 4, 6, 0.36127, 0.45244, munsellValueToY(4)},
+	{"GY", 7.5, 4, 12, 0.37697, 0.56804, munsellValueToY(4)},
+	{"GY", 7.5, 6, 2, 0.32635, 0.35804, munsellValueToY(6)},
+	{"GY", 7.5, 6, 6, 0.35071, 0.41912, munsellValueToY(6)},
+	{"GY", 7.5, 6, 12, 0.37404, 0.51061, munsellValueToY(6)},
+	{"GY", 7.5, 8, 2, 0.32357, 0.35189, munsellValueToY(8)},
+	{"GY", 7.5, 8, 6, 0.34365, 0.39975, munsellValueToY(8)},
+	{"GY", 7.5, 8, 12, 0.36676, 0.47332, munsellValueToY(8)},
+	{"GY", 10, 2, 2, 0.33032, 0.39022, munsellValueToY(2)},
+	{"GY", 10, 2, 6, 0.34822, 0.52611, munsellValueToY(2)},
+	{"GY", 10, 2, 12, 0.39518, 0.90113, munsellValueToY(2)},
+	{"GY", 10, 4, 2, 0.32451, 0.36763, munsellValueToY(4)},
+	{"GY", 10, 4, 6, 0.34194, 0.45170, munsellValueToY(4)},
+	{"GY", 10, 4, 12, 0.34293, 0.57950, munsellValueToY(4)},
+	{"GY", 10, 6, 2, 0.32156, 0.35720, munsellValueToY(6)},
+	{"GY", 10, 6, 6, 0.33629, 0.41770, munsellValueToY(6)},
+	{"GY", 10, 6, 12, 0.34686, 0.51360, munsellValueToY(6)},
+	{"GY", 10, 8, 2, 0.31978, 0.35120, munsellValueToY(8)},
+	{"GY", 10, 8, 6, 0.33220, 0.39829, munsellValueToY(8)},
+	{"GY", 10, 8, 12, 0.34451, 0.47350, munsellValueToY(8)},
+	{"G", 2.5, 2, 2, 0.31982, 0.38702, munsellValueToY(2)},
+	{"G", 2.5, 2, 6, 0.31819, 0.52032, munsellValueToY(2)},
+	{"G", 2.5, 2, 12, 0.30929, 0.84833, munsellValueToY(2)},
+	{"G", 2.5, 4, 2, 0.31774, 0.36549, munsellValueToY(4)},
+	{"G", 2.5, 4, 6, 0.32220, 0.44672, munsellValueToY(4)},
+	{"G", 2.5, 4, 12, 0.30871, 0.58004, munsellValueToY(4)},
+	{"G", 2.5, 6, 2, 0.31657, 0.35560, munsellValueToY(6)},
+	{"G", 2.5, 6, 6, 0.32149, 0.41347, munsellValueToY(6)},
+	{"G", 2.5, 6, 12, 0.31928, 0.50926, munsellValueToY(6)},
+	{"G", 2.5, 8, 2, 0.31584, 0.34993, munsellValueToY(8)},
+	{"G", 2.5, 8, 6, 0.32041, 0.39476, munsellValueToY(8)},
+	{"G", 2.5, 8, 12, 0.32183, 0.46841, munsellValueToY(8)},
+	{"G", 5, 2, 2, 0.30926, 0.38207, munsellValueToY(2)},
+	{"G", 5, 2, 6, 0.28963, 0.50759, munsellValueToY(2)},
+	{"G", 5, 2, 12, 0.23907, 0.77515, munsellValueToY(2)},
+	{"G", 5, 4, 2, 0.31091, 0.36233, munsellValueToY(4)},
+	{"G", 5, 4, 6, 0.30252, 0.43741, munsellValueToY(4)},
+	{"G", 5, 4, 12, 0.27488, 0.56719, munsellValueToY(4)},
+	{"G", 5, 6, 2, 0.31152, 0.35329, munsellValueToY(6)},
+	{"G", 5, 6, 6, 0.30667, 0.40647, munsellValueToY(6)},
+	{"G", 5, 6, 12, 0.29191, 0.49689, munsellValueToY(6)},
+	{"G", 5, 8, 2, 0.31183, 0.34810, munsellValueToY(8)},
+	{"G", 5, 8, 6, 0.30857, 0.38919, munsellValueToY(8)},
+	{"G", 5, 8, 12, 0.29927, 0.45782, munsellValueToY(8)},
+	{"G", 7.5, 2, 2, 0.29891, 0.37553, munsellValueToY(2)},
+	{"G", 7.5, 2, 6, 0.26207, 0.48617, munsellValueToY(2)},
+	{"G", 7.5, 2, 12, 0.18613, 0.69596, munsellValueToY(2)},
+	{"G", 7.5, 4, 2, 0.30417, 0.35824, munsellValueToY(4)},
+	{"G", 7.5, 4, 6, 0.28341, 0.42402, munsellValueToY(4)},
+	{"G", 7.5, 4, 12, 0.24225, 0.54016, munsellValueToY(4)},
+	{"G", 7.5, 6, 2, 0.30653, 0.35032, munsellValueToY(6)},
+	{"G", 7.5, 6, 6, 0.29221, 0.39688, munsellValueToY(6)},
+	{"G", 7.5, 6, 12, 0.26545, 0.47662, munsellValueToY(6)},
+	{"G", 7.5, 8, 2, 0.30787, 0.34578, munsellValueToY(8)},
+	{"G", 7.5, 8, 6, 0.29697, 0.38176, munsellValueToY(8)},
+	{"G", 7.5, 8, 12, 0.27740, 0.44199, munsellValueToY(8)},
+	{"G", 10, 2, 2, 0.28900, 0.36760, munsellValueToY(2)},
+	{"G", 10, 2, 6, 0.23626, 0.45728, munsellValueToY(2)},
+	{"G", 10, 2, 12, 0.14751, 0.61975, munsellValueToY(2)},
+	{"G", 10, 4, 2, 0.29769, 0.35335, munsellValueToY(4)},
+	{"G", 10, 4, 6, 0.26534, 0.40716, munsellValueToY(4)},
+	{"G", 10, 4, 12, 0.21186, 0.50063, munsellValueToY(4)},
+	{"G", 10, 6, 2, 0.30172, 0.34678, munsellValueToY(6)},
+	{"G", 10, 6, 6, 0.27847, 0.38509, munsellValueToY(6)},
+	{"G", 10, 6, 12, 0.24066, 0.44960, munsellValueToY(6)},
+	{"G", 10, 8, 2, 0.30405, 0.34301, munsellValueToY(8)},
+	{"G", 10, 8, 6, 0.28591, 0.37271, munsellValueToY(8)},
+	{"G", 10, 8, 12, 0.25681, 0.42170, munsellValueToY(8)},
+	{"BG", 2.5, 2, 2, 0.27979, 0.35857, munsellValueToY(2)},
+	{"BG", 2.5, 2, 6, 0.21297, 0.42312, munsellValueToY(2)},
+	{"BG", 2.5, 2, 12, 0.11669, 0.53026, munsellValueToY(2)},
+	{"BG", 2.5, 4, 2, 0.29163, 0.34779, munsellValueToY(4)},
+	{"BG", 2.5, 4, 6, 0.24877, 0.38770, munsellValueToY(4)},
+	{"BG", 2.5, 4, 12, 0.18477, 0.45262, munsellValueToY(4)},
+	{"BG", 2.5, 6, 2, 0.29721, 0.34278, munsellValueToY(6)},
+	{"BG", 2.5, 6, 6, 0.26575, 0.37159, munsellValueToY(6)},
+	{"BG", 2.5, 6, 12, 0.21822, 0.41779, munsellValueToY(6)},
+	{"BG", 2.5, 8, 2, 0.30046, 0.33987, munsellValueToY(8)},
+	{"BG", 2.5, 8, 6, 0.27563, 0.36240, munsellValueToY(8)},
+	{"BG", 2.5, 8, 12, 0.23801, 0.39814, munsellValueToY(8)},
+	{"BG", 5, 2, 2, 0.27146, 0.34878, munsellValueToY(2)},
+	{"BG", 5, 2, 6, 0.19280, 0.38644, munsellValueToY(2)},
+	{"BG", 5, 2, 12, 0.09294, 0.43424, munsellValueToY(2)},
+	{"BG", 5, 4, 2, 0.28611, 0.34176, munsellValueToY(4)},
+	{"BG", 5, 4, 6, 0.23406, 0.36669, munsellValueToY(4)},
+	{"BG", 5, 4, 12, 0.16182, 0.40127, munsellValueToY(4)},
+	{"BG", 5, 6, 2, 0.29309, 0.33842, munsellValueToY(6)},
+	{"BG", 5, 6, 6, 0.25435, 0.35697, munsellValueToY(6)},
+	{"BG", 5, 6, 12, 0.19872, 0.38360, munsellValueToY(6)},
+	{"BG", 5, 8, 2, 0.29717, 0.33647, munsellValueToY(8)},
+	{"BG", 5, 8, 6, 0.26636, 0.35122, munsellValueToY(8)},
+	{"BG", 5, 8, 12, 0.22143, 0.37273, munsellValueToY(8)},
+	{"BG", 7.5, 2, 2, 0.26419, 0.33858, munsellValueToY(2)},
+	{"BG", 7.5, 2, 6, 0.17610, 0.34986, munsellValueToY(2)},
+	{"BG", 7.5, 2, 12, 0.07638, 0.34706, munsellValueToY(2)},
+	{"BG", 7.5, 4, 2, 0.28127, 0.33544, munsellValueToY(4)},
+	{"BG", 7.5, 4, 6, 0.22148, 0.34517, munsellValueToY(4)},
+	{"BG", 7.5, 4, 12, 0.14341, 0.35131, munsellValueToY(4)},
+	{"BG", 7.5, 6, 2, 0.28947, 0.33385, munsellValueToY(6)},
+	{"BG", 7.5, 6, 6, 0.24449, 0.34185, munsellValueToY(6)},
+	{"BG", 7.5, 6, 12, 0.18249, 0.34937, munsellValueToY(6)},
+	{"BG", 7.5, 8, 2, 0.29428, 0.33289, munsellValueToY(8)},
+	{"BG", 7.5, 8, 6, 0.25828, 0.33960, munsellValueToY(8)},
+	{"BG", 7.5, 8, 12, 0.20738, 0.34691, munsellValueToY(8)},
+	{"BG", 10, 2, 2, 0.25812, 0.32831, munsellValueToY(2)},
+	{"BG", 10, 2, 6, 0.16299, 0.31547, munsellValueToY(2)},
+	{"BG", 10, 2, 12, 0.06569, 0.27601, munsellValueToY(2)},
+	{"BG", 10, 4, 2, 0.27720, 0.32903, munsellValueToY(4)},
+	{"BG", 10, 4, 6, 0.21123, 0.32412, munsellValueToY(4)},
+	{"BG", 10, 4, 12, 0.12951, 0.30605, munsellValueToY(4)},
+	{"BG", 10, 6, 2, 0.28642, 0.32919, munsellValueToY(6)},
+	{"BG", 10, 6, 6, 0.23633, 0.32682, munsellValueToY(6)},
+	{"BG", 10, 6, 12, 0.16967, 0.31698, munsellValueToY(6)},
+	{"BG", 10, 8, 2, 0.29184, 0.32923, munsellValueToY(8)},
+	{"BG", 10, 8, 6, 0.25157, 0.32794, munsellValueToY(8)},
+	{"BG", 10, 8, 12, 0.19605, 0.32193, munsellValueToY(8)},
+	{"B", 2.5, 2, 2, 0.25336, 0.31831, munsellValueToY(2)},
+	{"B", 2.5, 2, 6, 0.15342, 0.28462, munsellValueToY(2)},
+	{"B", 2.5, 2, 12, 0.05929, 0.22168, munsellValueToY(2)},
+	{"B", 2.5, 4, 2, 0.27399, 0.32270, munsellValueToY(4)},
+	{"B", 2.5, 4, 6, 0.20340, 0.30432, munsellValueToY(4)},
+	{"B", 2.5, 4, 12, 0.11981, 0.26714, munsellValueToY(4)},
+	{"B", 2.5, 6, 2, 0.28400, 0.32457, munsellValueToY(6)},
+	{"B", 2.5, 6, 6, 0.23000, 0.31239, munsellValueToY(6)},
+	{"B", 2.5, 6, 12, 0.16024, 0.28771, munsellValueToY(6)},
+	{"B", 2.5, 8, 2, 0.28991, 0.32560, munsellValueToY(8)},
+	{"B", 2.5, 8, 6, 0.24631, 0.31663, munsellValueToY(8)},
+	{"B", 2.5, 8, 12, 0.18750, 0.29874, munsellValueToY(8)},
+	{"B", 5, 2, 2, 0.24999, 0.30885, munsellValueToY(2)},
+	{"B", 5, 2, 6, 0.14721, 0.25800, munsellValueToY(2)},
+	{"B", 5, 2, 12, 0.05600, 0.18157, munsellValueToY(2)},
+	{"B", 5, 4, 2, 0.27170, 0.31665, munsellValueToY(4)},
+	{"B", 5, 4, 6, 0.19803, 0.28632, munsellValueToY(4)},
+	{"B", 5, 4, 12, 0.11389, 0.23499, munsellValueToY(4)},
+	{"B", 5, 6, 2, 0.28228, 0.32012, munsellValueToY(6)},
+	{"B", 5, 6, 6, 0.22557, 0.29898, munsellValueToY(6)},
+	{"B", 5, 6, 12, 0.15406, 0.26224, munsellValueToY(6)},
+	{"B", 5, 8, 2, 0.28852, 0.32208, munsellValueToY(8)},
+	{"B", 5, 8, 6, 0.24260, 0.30599, munsellValueToY(8)},
+	{"B", 5, 8, 12, 0.18171, 0.27798, munsellValueToY(8)},
+	{"B", 7.5, 2, 2, 0.24806, 0.30018, munsellValueToY(2)},
+	{"B", 7.5, 2, 6, 0.14417, 0.23575, munsellValueToY(2)},
+	{"B", 7.5, 2, 12, 0.05558, 0.15244, munsellValueToY(2)},
+	{"B", 7.5, 4, 2, 0.27038, 0.31101, munsellValueToY(4)},
+	{"B", 7.5, 4, 6, 0.19509, 0.27049, munsellValueToY(4)},
+	{"B", 7.5, 4, 12, 0.11135, 0.20923, munsellValueToY(4)},
+	{"B", 7.5, 6, 2, 0.28127, 0.31595, munsellValueToY(6)},
+	{"B", 7.5, 6, 6, 0.22308, 0.28691, munsellValueToY(6)},
+	{"B", 7.5, 6, 12, 0.15097, 0.24080, munsellValueToY(6)},
+	{"B", 7.5, 8, 2, 0.28772, 0.31878, munsellValueToY(8)},
+	{"B", 7.5, 8, 6, 0.24049, 0.29629, munsellValueToY(8)},
+	{"B", 7.5, 8, 12, 0.17864, 0.26000, munsellValueToY(8)},
+	{"B", 10, 2, 2, 0.24759, 0.29248, munsellValueToY(2)},
+	{"B", 10, 2, 6, 0.14412, 0.21775, munsellValueToY(2)},
+	{"B", 10, 2, 12, 0.05770, 0.13157, munsellValueToY(2)},
+	{"B", 10, 4, 2, 0.27004, 0.30592, munsellValueToY(4)},
+	{"B", 10, 4, 6, 0.19457, 0.25703, munsellValueToY(4)},
+	{"B", 10, 4, 12, 0.11193, 0.18915, munsellValueToY(4)},
+	{"B", 10, 6, 2, 0.28102, 0.31217, munsellValueToY(6)},
+	{"B", 10, 6, 6, 0.22253, 0.27640, munsellValueToY(6)},
+	{"B", 10, 6, 12, 0.15082, 0.22332, munsellValueToY(6)},
+	{"B", 10, 8, 2, 0.28751, 0.31576, munsellValueToY(8)},
+	{"B", 10, 8, 6, 0.23998, 0.28773, munsellValueToY(8)},
+	{"B", 10, 8, 12, 0.17821, 0.24493, munsellValueToY(8)},
+	{"PB", 2.5, 2, 2, 0.24858, 0.28590, munsellValueToY(2)},
+	{"PB", 2.5, 2, 6, 0.14695, 0.20367, munsellValueToY(2)},
+	{"PB", 2.5, 2, 12, 0.06227, 0.11691, munsellValueToY(2)},
+	{"PB", 2.5, 4, 2, 0.27070, 0.30150, munsellValueToY(4)},
+	{"PB", 2.5, 4, 6, 0.19642, 0.24600, munsellValueToY(4)},
+	{"PB", 2.5, 4, 12, 0.11547, 0.17399, munsellValueToY(4)},
+	{"PB", 2.5, 6, 2, 0.28151, 0.30884, munsellValueToY(6)},
+	{"PB", 2.5, 6, 6, 0.22391, 0.26759, munsellValueToY(6)},
+	{"PB", 2.5, 6, 12, 0.15352, 0.20957, munsellValueToY(6)},
+	{"PB", 2.5, 8, 2, 0.28791, 0.31310, munsellValueToY(8)},
+	{"PB", 2.5, 8, 6, 0.24109, 0.28044, munsellValueToY(8)},
+	{"PB", 2.5, 8, 12, 0.18036, 0.23276, munsellValueToY(8)},
+	{"PB", 5, 2, 2, 0.25102, 0.28052, munsellValueToY(2)},
+	{"PB", 5, 2, 6, 0.15263, 0.19318, munsellValueToY(2)},
+	{"PB", 5, 2, 12, 0.06941, 0.10698, munsellValueToY(2)},
+	{"PB", 5, 4, 2, 0.27233, 0.29782, munsellValueToY(4)},
+	{"PB", 5, 4, 6, 0.20062, 0.23739, munsellValueToY(4)},
+	{"PB", 5, 4, 12, 0.12194, 0.16305, munsellValueToY(4)},
+	{"PB", 5, 6, 2, 0.28274, 0.30606, munsellValueToY(6)},
+	{"PB", 5, 6, 6, 0.22720, 0.26054, munsellValueToY(6)},
+	{"PB", 5, 6, 12, 0.15901, 0.19925, munsellValueToY(6)},
+	{"PB", 5, 8, 2, 0.28890, 0.31086, munsellValueToY(8)},
+	{"PB", 5, 8, 6, 0.24379, 0.27453, munsellValueToY(8)},
+	{"PB", 5, 8, 12, 0.18506, 0.22340, munsellValueToY(8)},
+	{"PB", 7.5, 2, 2, 0.25486, 0.27642, munsellValueToY(2)},
+	{"PB", 7.5, 2, 6, 0.16118, 0.18595, munsellValueToY(2)},
+	{"PB", 7.5, 2, 12, 0.07945, 0.10080, munsellValueToY(2)},
+	{"PB", 7.5, 4, 2, 0.27492, 0.29495, munsellValueToY(4)},
+	{"PB", 7.5, 4, 6, 0.20712, 0.23113, munsellValueToY(4)},
+	{"PB", 7.5, 4, 12, 0.13144, 0.15577, munsellValueToY(4)},
+	{"PB", 7.5, 6, 2, 0.28469, 0.30386, munsellValueToY(6)},
+	{"PB", 7.5, 6, 6, 0.23235, 0.25528, munsellValueToY(6)},
+	{"PB", 7.5, 6, 12, 0.16732, 0.19208, munsellValueToY(6)},
+	{"PB", 7.5, 8, 2, 0.29046, 0.30909, munsellValueToY(8)},
+	{"PB", 7.5, 8, 6, 0.24804, 0.27005, munsellValueToY(8)},
+	{"PB", 7.5, 8, 12, 0.19228, 0.21672, munsellValueToY(8)},
+	{"PB", 10, 2, 2, 0.26005, 0.27360, munsellValueToY(2)},
+	{"PB", 10, 2, 6, 0.17268, 0.18168, munsellValueToY(2)},
+	{"PB", 10, 2, 12, 0.09288, 0.09772, munsellValueToY(2)},
+	{"PB", 10, 4, 2, 0.27841, 0.29292, munsellValueToY(4)},
+	{"PB", 10, 4, 6, 0.21590, 0.22716, munsellValueToY(4)},
+	{"PB", 10, 4, 12, 0.14419, 0.15170, munsellValueToY(4)},
+	{"PB", 10, 6, 2, 0.28732, 0.30229, munsellValueToY(6)},
+	{"PB", 10, 6, 6, 0.23933, 0.25180, munsellValueToY(6)},
+	{"PB", 10, 6, 12, 0.17851, 0.18781, munsellValueToY(6)},
+	{"PB", 10, 8, 2, 0.29256, 0.30780, munsellValueToY(8)},
+	{"PB", 10, 8, 6, 0.25379, 0.26701, munsellValueToY(8)},
+	{"PB", 10, 8, 12, 0.20203, 0.21256, munsellValueToY(8)},
+	{"P", 2.5, 2, 2, 0.26652, 0.27209, munsellValueToY(2)},
+	{"P", 2.5, 2, 6, 0.18726, 0.18017, munsellValueToY(2)},
+	{"P", 2.5, 2, 12, 0.11042, 0.09740, munsellValueToY(2)},
+	{"P", 2.5, 4, 2, 0.28274, 0.29177, munsellValueToY(4)},
+	{"P", 2.5, 4, 6, 0.22693, 0.22536, munsellValueToY(4)},
+	{"P", 2.5, 4, 12, 0.16046, 0.15056, munsellValueToY(4)},
+	{"P", 2.5, 6, 2, 0.29056, 0.30137, munsellValueToY(6)},
+	{"P", 2.5, 6, 6, 0.24804, 0.25006, munsellValueToY(6)},
+	{"P", 2.5, 6, 12, 0.19267, 0.18624, munsellValueToY(6)},
+	{"P", 2.5, 8, 2, 0.29515, 0.30704, munsellValueToY(8)},
+	{"P", 2.5, 8, 6, 0.26095, 0.26541, munsellValueToY(8)},
+	{"P", 2.5, 8, 12, 0.21431, 0.21081, munsellValueToY(8)},
+	{"P", 5, 2, 2, 0.27414, 0.27186, munsellValueToY(2)},
+	{"P", 5, 2, 6, 0.20502, 0.18124, munsellValueToY(2)},
+	{"P", 5, 2, 12, 0.13292, 0.09968, munsellValueToY(2)},
+	{"P", 5, 4, 2, 0.28782, 0.29148, munsellValueToY(4)},
+	{"P", 5, 4, 6, 0.24014, 0.22565, munsellValueToY(4)},
+	{"P", 5, 4, 12, 0.18058, 0.15216, munsellValueToY(4)},
+	{"P", 5, 6, 2, 0.29436, 0.30110, munsellValueToY(6)},
+	{"P", 5, 6, 6, 0.25839, 0.25002, munsellValueToY(6)},
+	{"P", 5, 6, 12, 0.20988, 0.18720, munsellValueToY(6)},
+	{"P", 5, 8, 2, 0.29819, 0.30680, munsellValueToY(8)},
+	{"P", 5, 8, 6, 0.26942, 0.26523, munsellValueToY(8)},
+	{"P", 5, 8, 12, 0.22909, 0.21133, munsellValueToY(8)},
+	{"P", 7.5, 2, 2, 0.28280, 0.27288, munsellValueToY(2)},
+	{"P", 7.5, 2, 6, 0.22606, 0.18479, munsellValueToY(2)},
+	{"P", 7.5, 2, 12, 0.16142, 0.10454, munsellValueToY(2)},
+	{"P", 7.5, 4, 2, 0.29354, 0.29205, munsellValueToY(4)},
+	{"P", 7.5, 4, 6, 0.25543, 0.22793, munsellValueToY(4)},
+	{"P", 7.5, 4, 12, 0.20488, 0.15640, munsellValueToY(4)},
+	{"P", 7.5, 6, 2, 0.29863, 0.30148, munsellValueToY(6)},
+	{"P", 7.5, 6, 6, 0.27024, 0.25161, munsellValueToY(6)},
+	{"P", 7.5, 6, 12, 0.23019, 0.19058, munsellValueToY(6)},
+	{"P", 7.5, 8, 2, 0.30159, 0.30709, munsellValueToY(8)},
+	{"P", 7.5, 8, 6, 0.27905, 0.26641, munsellValueToY(8)},
+	{"P", 7.5, 8, 12, 0.24631, 0.21404, munsellValueToY(8)},
+	{"P", 10, 2, 2, 0.29232, 0.27509, munsellValueToY(2)},
+	{"P", 10, 2, 6, 0.25036, 0.19072, munsellValueToY(2)},
+	{"P", 10, 2, 12, 0.19699, 0.11212, munsellValueToY(2)},
+	{"P", 10, 4, 2, 0.29979, 0.29345, munsellValueToY(4)},
+	{"P", 10, 4, 6, 0.27260, 0.23213, munsellValueToY(4)},
+	{"P", 10, 4, 12, 0.23358, 0.16324, munsellValueToY(4)},
+	{"P", 10, 6, 2, 0.30327, 0.30250, munsellValueToY(6)},
+	{"P", 10, 6, 6, 0.28339, 0.25476, munsellValueToY(6)},
+	{"P", 10, 6, 12, 0.25356, 0.19630, munsellValueToY(6)},
+	{"P", 10, 8, 2, 0.30528, 0.30788, munsellValueToY(8)},
+	{"P", 10, 8, 6, 0.28968, 0.26891, munsellValueToY(8)},
+	{"P", 10, 8, 12, 0.26582, 0.21882, munsellValueToY(8)},
+	{"RP", 2.5, 2, 2, 0.30251, 0.27844, munsellValueToY(2)},
+	{"RP", 2.5, 2, 6, 0.27774, 0.19896, munsellValueToY(2)},
+	{"RP", 2.5, 2, 12, 0.24058, 0.12258, munsellValueToY(2)},
+	{"RP", 2.5, 4, 2, 0.30642, 0.29564, munsellValueToY(4)},
+	{"RP", 2.5, 4, 6, 0.29140, 0.23813, munsellValueToY(4)},
+	{"RP", 2.5, 4, 12, 0.26672, 0.17265, munsellValueToY(4)},
+	{"RP", 2.5, 6, 2, 0.30819, 0.30412, munsellValueToY(6)},
+	{"RP", 2.5, 6, 6, 0.29761, 0.25939, munsellValueToY(6)},
+	{"RP", 2.5, 6, 12, 0.27978, 0.20427, munsellValueToY(6)},
+	{"RP", 2.5, 8, 2, 0.30918, 0.30917, munsellValueToY(8)},
+	{"RP", 2.5, 8, 6, 0.30108, 0.27265, munsellValueToY(8)},
+	{"RP", 2.5, 8, 12, 0.28736, 0.22559, munsellValueToY(8)},
+	{"RP", 5, 2, 2, 0.31312, 0.28285, munsellValueToY(2)},
+	{"RP", 5, 2, 6, 0.30780, 0.20941, munsellValueToY(2)},
+	{"RP", 5, 2, 12, 0.29261, 0.13606, munsellValueToY(2)},
+	{"RP", 5, 4, 2, 0.31328, 0.29857, munsellValueToY(4)},
+	{"RP", 5, 4, 6, 0.31145, 0.24582, munsellValueToY(4)},
+	{"RP", 5, 4, 12, 0.30399, 0.18458, munsellValueToY(4)},
+	{"RP", 5, 6, 2, 0.31325, 0.30631, munsellValueToY(6)},
+	{"RP", 5, 6, 6, 0.31257, 0.26539, munsellValueToY(6)},
+	{"RP", 5, 6, 12, 0.30843, 0.21438, munsellValueToY(6)},
+	{"RP", 5, 8, 2, 0.31319, 0.31091, munsellValueToY(8)},
+	{"RP", 5, 8, 6, 0.31300, 0.27755, munsellValueToY(8)},
+	{"RP", 5, 8, 12, 0.31052, 0.23423, munsellValueToY(8)},
+	{"RP", 7.5, 2, 2, 0.32391, 0.28823, munsellValueToY(2)},
+	{"RP", 7.5, 2, 6, 0.33984, 0.22195, munsellValueToY(2)},
+	{"RP", 7.5, 2, 12, 0.35250, 0.15259, munsellValueToY(2)},
+	{"RP", 7.5, 4, 2, 0.32019, 0.30217, munsellValueToY(4)},
+	{"RP", 7.5, 4, 6, 0.33226, 0.25509, munsellValueToY(4)},
+	{"RP", 7.5, 4, 12, 0.34458, 0.19893, munsellValueToY(4)},
+	{"RP", 7.5, 6, 2, 0.31833, 0.30901, munsellValueToY(6)},
+	{"RP", 7.5, 6, 6, 0.32792, 0.27267, munsellValueToY(6)},
+	{"RP", 7.5, 6, 12, 0.33884, 0.22652, munsellValueToY(6)},
+	{"RP", 7.5, 8, 2, 0.31721, 0.31308, munsellValueToY(8)},
+	{"RP", 7.5, 8, 6, 0.32514, 0.28351, munsellValueToY(8)},
+	{"RP", 7.5, 8, 12, 0.33474, 0.24460, munsellValueToY(8)},
+	{"RP", 10, 2, 2, 0.33457, 0.29448, munsellValueToY(2)},
+	{"RP", 10, 2, 6, 0.37281, 0.23639, munsellValueToY(2)},
+	{"RP", 10, 2, 12, 0.41807, 0.17191, munsellValueToY(2)},
+	{"RP", 10, 4, 2, 0.32699, 0.30637, munsellValueToY(4)},
+	{"RP", 10, 4, 6, 0.35321, 0.26578, munsellValueToY(4)},
+	{"RP", 10, 4, 12, 0.38711, 0.21548, munsellValueToY(4)},
+	{"RP", 10, 6, 2, 0.32331, 0.31217, munsellValueToY(6)},
+	{"RP", 10, 6, 6, 0.34322, 0.28108, munsellValueToY(6)},
+	{"RP", 10, 6, 12, 0.37004, 0.24049, munsellValueToY(6)},
+	{"RP", 10, 8, 2, 0.32114, 0.31561, munsellValueToY(8)},
+	{"RP", 10, 8, 6, 0.33717, 0.29043, munsellValueToY(8)},
+	{"RP", 10, 8, 12, 0.35929, 0.25656, munsellValueToY(8)},
+}
+
+func (c munsellChip) lab() labComponents {
+	x, y, z := xyYToXYZ(xyYComponents{X: c.x, Y: c.y, LargeY: c.Y})
+	return xyzToLab(x, y, z)
+}
@@ -0,0 +1,148 @@
+package munsell
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Notation is a Munsell color specification in Hue Value/Chroma form, e.g.
+// "5R 4/14" is HueLetter "R", HuePrefix 5, Value 4, Chroma 14.
+type Notation struct {
+	HueLetter string
+	HuePrefix float64
+	Value     float64
+	Chroma    float64
+}
+
+// hueFamilies lists the 10 principal Munsell hues in circular order.
+var hueFamilies = [...]string{"R", "YR", "Y", "GY", "G", "BG", "B", "PB", "P", "RP"}
+
+func hueFamilyIndex(letter string) (int, bool) {
+	for i, f := range hueFamilies {
+		if f == letter {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// String renders the notation as standard Munsell text, e.g. "5R 4/14".
+func (n Notation) String() string {
+	return fmt.Sprintf("%s %s/%s", formatMunsellNumber(n.HuePrefix)+n.HueLetter, formatMunsellNumber(n.Value), formatMunsellNumber(n.Chroma))
+}
+
+func formatMunsellNumber(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+var notationPattern = regexp.MustCompile(`^\s*(\d+(?:\.\d+)?)\s*([A-Za-z]+)\s+(\d+(?:\.\d+)?)\s*/\s*(\d+(?:\.\d+)?)\s*$`)
+
+// Parse reads a standard Munsell notation string such as "5R 4/14" into a
+// Notation. The hue letter must be one of the 10 principal families (R, YR,
+// Y, GY, G, BG, B, PB, P, RP).
+func Parse(s string) (Notation, error) {
+	m := notationPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Notation{}, fmt.Errorf("munsell: invalid notation %q", s)
+	}
+	letter := strings.ToUpper(m[2])
+	if _, ok := hueFamilyIndex(letter); !ok {
+		return Notation{}, fmt.Errorf("munsell: unknown hue family %q in %q", m[2], s)
+	}
+	prefix, _ := strconv.ParseFloat(m[1], 64)
+	value, _ := strconv.ParseFloat(m[3], 64)
+	chroma, _ := strconv.ParseFloat(m[4], 64)
+	return Notation{HueLetter: letter, HuePrefix: prefix, Value: value, Chroma: chroma}, nil
+}
+
+// CoarseColor maps the notation's hue family to the package's coarse Color
+// enum, the same 10-bucket classification matchColorFromHSL produces. It is
+// a thin wrapper, not a replacement for the HSL/HSLuv classifiers.
+func (n Notation) CoarseColor() Color {
+	if n.Value >= 9 && n.Chroma <= 1 {
+		return White
+	}
+	if n.Value <= 1.5 {
+		return Black
+	}
+	switch n.HueLetter {
+	case "R", "RP":
+		return Red
+	case "YR":
+		return Orange
+	case "Y":
+		return Yellow
+	case "GY", "G":
+		return Green
+	case "BG":
+		return LightBlue
+	case "B", "PB":
+		return Blue
+	case "P":
+		return Purple
+	}
+	return Unknown
+}
+
+// ToMunsell converts the color to its nearest Munsell notation. See
+// NotationFromRGB for the accuracy caveat on the placeholder chip table it
+// searches.
+func (rgb RGBComponents) ToMunsell() Notation {
+	return NotationFromRGB(rgb)
+}
+
+// NotationFromRGB finds the nearest Munsell chip(s) to rgb in the chip
+// dataset (by CIE L*a*b* distance) and linearly interpolates Value and
+// Chroma across the nearest few chips for a smoother result than a single
+// nearest-neighbor lookup would give.
+//
+// munsellChips is currently a synthetic placeholder rather than the
+// published Munsell Renotation (see its doc comment in munsell_chips.go),
+// so treat the result as an approximate notation, not a precise one, until
+// SetRenotationChips has been called with real chip data.
+func NotationFromRGB(rgb RGBComponents) Notation {
+	target := rgb.toLab()
+
+	type chipDistance struct {
+		chip munsellChip
+		dist float64
+	}
+	dists := make([]chipDistance, len(munsellChips))
+	for i, c := range munsellChips {
+		dists[i] = chipDistance{chip: c, dist: labDistance(target, c.lab())}
+	}
+	sort.Slice(dists, func(i, j int) bool { return dists[i].dist < dists[j].dist })
+
+	const neighbors = 3
+	if len(dists) < neighbors {
+		return notationFromChip(dists[0].chip)
+	}
+
+	var weightSum, value, chroma float64
+	for _, d := range dists[:neighbors] {
+		w := 1 / (d.dist + 1e-6)
+		weightSum += w
+		value += w * d.chip.value
+		chroma += w * d.chip.chroma
+	}
+
+	nearest := dists[0].chip
+	return Notation{
+		HueLetter: nearest.hueLetter,
+		HuePrefix: nearest.huePrefix,
+		Value:     roundTo(value/weightSum, 100),
+		Chroma:    roundTo(chroma/weightSum, 100),
+	}
+}
+
+func roundTo(f float64, precision float64) float64 {
+	return math.Round(f*precision) / precision
+}
+
+func notationFromChip(c munsellChip) Notation {
+	return Notation{HueLetter: c.hueLetter, HuePrefix: c.huePrefix, Value: c.value, Chroma: c.chroma}
+}
@@ -0,0 +1,43 @@
+package munsell
+
+import "testing"
+
+// NotationFromRGB currently searches a synthetic placeholder chip table
+// (see munsellChips' doc comment in munsell_chips.go), not the published
+// Munsell Renotation, so these are coarse sanity checks rather than golden
+// values pinned against a real reference chip. Tighten them to exact
+// published notations once the table is backed by real chip data.
+func TestNotationFromRGB_RedIsInRedHalfOfHueWheel(t *testing.T) {
+	got := NotationFromRGB(RGBComponents{Red: 200, Green: 40, Blue: 40})
+	switch got.HueLetter {
+	case "R", "YR", "RP":
+	default:
+		t.Errorf("NotationFromRGB(saturated red).HueLetter = %q, want one of R/YR/RP", got.HueLetter)
+	}
+}
+
+func TestNotationFromRGB_OpposingHuesDiffer(t *testing.T) {
+	red := NotationFromRGB(RGBComponents{Red: 200, Green: 40, Blue: 40})
+	blue := NotationFromRGB(RGBComponents{Red: 40, Green: 40, Blue: 200})
+	if red.HueLetter == blue.HueLetter {
+		t.Errorf("expected distinct hue families for red and blue, both got %q", red.HueLetter)
+	}
+}
+
+func TestNotationFromRGB_DarkerIsLowerValue(t *testing.T) {
+	dark := NotationFromRGB(RGBComponents{Red: 60, Green: 0, Blue: 0})
+	light := NotationFromRGB(RGBComponents{Red: 220, Green: 180, Blue: 180})
+	if dark.Value >= light.Value {
+		t.Errorf("expected dark red Value (%v) < light pink Value (%v)", dark.Value, light.Value)
+	}
+}
+
+func TestMunsellValueToY(t *testing.T) {
+	// Known anchor from the ASTM/Munsell Value function: V=5 maps to the
+	// textbook mid-gray reflectance Y=19.77.
+	got := munsellValueToY(5)
+	const want = 19.77
+	if diff := got - want; diff > 0.01 || diff < -0.01 {
+		t.Errorf("munsellValueToY(5) = %v, want ~%v", got, want)
+	}
+}
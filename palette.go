@@ -0,0 +1,104 @@
+package munsell
+
+// Classifier maps an RGB color to the name of the closest match in some
+// reference set, along with the distance to it. Palette is the built-in
+// implementation; callers can provide their own.
+type Classifier interface {
+	Classify(rgb RGBComponents) (name string, distance float64)
+}
+
+// PaletteEntry is one named reference color in a Palette.
+type PaletteEntry struct {
+	Name string
+	RGB  RGBComponents
+}
+
+// Palette is a named set of reference colors that RGB values can be
+// classified against by nearest CIEDE2000 distance.
+type Palette struct {
+	Entries []PaletteEntry
+}
+
+// NewPalette builds a Palette from explicit entries.
+func NewPalette(entries ...PaletteEntry) *Palette {
+	return &Palette{Entries: entries}
+}
+
+// NewPaletteFromMap builds a Palette from a name->RGB map. Map iteration
+// order is unspecified, which only affects tie-breaking in Nearest.
+func NewPaletteFromMap(colors map[string]RGBComponents) *Palette {
+	entries := make([]PaletteEntry, 0, len(colors))
+	for name, rgb := range colors {
+		entries = append(entries, PaletteEntry{Name: name, RGB: rgb})
+	}
+	return &Palette{Entries: entries}
+}
+
+// NewCoarsePalette builds the Palette equivalent of the package's original
+// coarse Color buckets, one entry each for White, Black, Red, Orange,
+// Yellow, Green, Light Blue, Blue and Purple (Unknown has no entry), keyed
+// by Color.String().
+func NewCoarsePalette() *Palette {
+	entries := make([]PaletteEntry, 0, len(representativeColors))
+	for color, rgb := range representativeColors {
+		entries = append(entries, PaletteEntry{Name: color.String(), RGB: rgb})
+	}
+	return &Palette{Entries: entries}
+}
+
+// NewANSIPalette builds a Palette of the 16 standard ANSI terminal colors.
+func NewANSIPalette() *Palette {
+	return NewPalette(
+		PaletteEntry{"Black", RGBComponents{0, 0, 0}},
+		PaletteEntry{"Red", RGBComponents{170, 0, 0}},
+		PaletteEntry{"Green", RGBComponents{0, 170, 0}},
+		PaletteEntry{"Yellow", RGBComponents{170, 85, 0}},
+		PaletteEntry{"Blue", RGBComponents{0, 0, 170}},
+		PaletteEntry{"Magenta", RGBComponents{170, 0, 170}},
+		PaletteEntry{"Cyan", RGBComponents{0, 170, 170}},
+		PaletteEntry{"White", RGBComponents{170, 170, 170}},
+		PaletteEntry{"Bright Black", RGBComponents{85, 85, 85}},
+		PaletteEntry{"Bright Red", RGBComponents{255, 85, 85}},
+		PaletteEntry{"Bright Green", RGBComponents{85, 255, 85}},
+		PaletteEntry{"Bright Yellow", RGBComponents{255, 255, 85}},
+		PaletteEntry{"Bright Blue", RGBComponents{85, 85, 255}},
+		PaletteEntry{"Bright Magenta", RGBComponents{255, 85, 255}},
+		PaletteEntry{"Bright Cyan", RGBComponents{85, 255, 255}},
+		PaletteEntry{"Bright White", RGBComponents{255, 255, 255}},
+	)
+}
+
+// Nearest returns the name of the Palette entry closest to rgb, and the
+// CIEDE2000 distance to it (kL, kC, kH all 1, as in the reference formula).
+// If p has no entries, Nearest returns ("", -1): -1 is not a distance any
+// real palette entry can have (CIEDE2000 distances are non-negative), so
+// it doubles as a sentinel for "nothing to compare against".
+func (p *Palette) Nearest(rgb RGBComponents) (name string, distance float64) {
+	target := rgb.toLab()
+	best := ""
+	bestDist := -1.0
+	for _, entry := range p.Entries {
+		d := deltaE2000(target, entry.RGB.toLab(), 1, 1, 1)
+		if bestDist < 0 || d < bestDist {
+			bestDist = d
+			best = entry.Name
+		}
+	}
+	return best, bestDist
+}
+
+// Classify implements Classifier.
+func (p *Palette) Classify(rgb RGBComponents) (name string, distance float64) {
+	return p.Nearest(rgb)
+}
+
+// colorFromName reverses Color.String() for the coarse bucket names
+// produced by NewCoarsePalette.
+func colorFromName(name string) Color {
+	for c := Unknown; c <= Purple; c++ {
+		if c.String() == name {
+			return c
+		}
+	}
+	return Unknown
+}
@@ -0,0 +1,142 @@
+package munsell
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ErrNoChips is returned by SetRenotationChips when given no chips: an
+// empty chip table would leave NotationFromRGB with nothing to compare
+// against.
+var ErrNoChips = errors.New("munsell: no renotation chips given")
+
+// RenotationChip is one published Munsell renotation chip: a Hue
+// Value/Chroma coordinate and the CIE xyY chromaticity measured for it.
+// Pass real chips (e.g. from ParseRenotationData) to SetRenotationChips to
+// replace the package's built-in placeholder table.
+type RenotationChip struct {
+	HueLetter string
+	HuePrefix float64
+	Value     float64
+	Chroma    float64
+	X, Y      float64
+	LargeY    float64
+}
+
+// SetRenotationChips replaces the chip table that NotationFromRGB and
+// ToMunsell search. The package ships with a synthetic placeholder table
+// (see munsellChip's doc comment); calling this with real chip data, such
+// as the result of ParseRenotationData fed the standard
+// "real.dat"/"all.dat" renotation files, is what makes those conversions
+// accurate. Returns ErrNoChips, and leaves the existing table untouched,
+// if chips is empty.
+func SetRenotationChips(chips []RenotationChip) error {
+	if len(chips) == 0 {
+		return ErrNoChips
+	}
+	converted := make([]munsellChip, len(chips))
+	for i, c := range chips {
+		converted[i] = munsellChip{
+			hueLetter: c.HueLetter,
+			huePrefix: c.HuePrefix,
+			value:     c.Value,
+			chroma:    c.Chroma,
+			x:         c.X,
+			y:         c.Y,
+			Y:         c.LargeY,
+		}
+	}
+	munsellChips = converted
+	return nil
+}
+
+// ParseRenotationData reads the standard Munsell renotation file format
+// published alongside the Munsell Renotation (Newhall, Nickerson & Judd)
+// as "real.dat"/"all.dat": a mandatory header line (e.g. "H V C x y Y",
+// discarded unconditionally) followed by whitespace-separated "H V C x y
+// Y" data rows, where H is a Munsell hue like "5R" or "10PB". Feed the
+// result to SetRenotationChips.
+func ParseRenotationData(r io.Reader) ([]RenotationChip, error) {
+	var chips []RenotationChip
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if lineNum == 1 {
+			continue // header row
+		}
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		chip, err := parseRenotationRow(fields)
+		if err != nil {
+			return nil, fmt.Errorf("munsell: renotation line %d: %w", lineNum, err)
+		}
+		chips = append(chips, chip)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(chips) == 0 {
+		return nil, fmt.Errorf("munsell: no renotation rows found")
+	}
+	return chips, nil
+}
+
+func parseRenotationRow(fields []string) (RenotationChip, error) {
+	if len(fields) != 6 {
+		return RenotationChip{}, fmt.Errorf("want 6 fields, got %d", len(fields))
+	}
+	letter, prefix, err := splitHue(fields[0])
+	if err != nil {
+		return RenotationChip{}, err
+	}
+	v, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return RenotationChip{}, fmt.Errorf("bad value %q", fields[1])
+	}
+	c, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return RenotationChip{}, fmt.Errorf("bad chroma %q", fields[2])
+	}
+	x, err := strconv.ParseFloat(fields[3], 64)
+	if err != nil {
+		return RenotationChip{}, fmt.Errorf("bad x %q", fields[3])
+	}
+	y, err := strconv.ParseFloat(fields[4], 64)
+	if err != nil {
+		return RenotationChip{}, fmt.Errorf("bad y %q", fields[4])
+	}
+	largeY, err := strconv.ParseFloat(fields[5], 64)
+	if err != nil {
+		return RenotationChip{}, fmt.Errorf("bad Y %q", fields[5])
+	}
+	return RenotationChip{HueLetter: letter, HuePrefix: prefix, Value: v, Chroma: c, X: x, Y: y, LargeY: largeY}, nil
+}
+
+// splitHue splits a Munsell hue like "5R" or "2.5PB" into its numeric
+// prefix and principal hue letter.
+func splitHue(s string) (letter string, prefix float64, err error) {
+	i := 0
+	for i < len(s) && (s[i] == '.' || (s[i] >= '0' && s[i] <= '9')) {
+		i++
+	}
+	if i == 0 {
+		return "", 0, fmt.Errorf("invalid hue %q: no numeric prefix", s)
+	}
+	prefix, err = strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid hue prefix in %q", s)
+	}
+	letter = strings.ToUpper(s[i:])
+	if _, ok := hueFamilyIndex(letter); !ok {
+		return "", 0, fmt.Errorf("unknown hue family %q in %q", letter, s)
+	}
+	return letter, prefix, nil
+}
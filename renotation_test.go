@@ -0,0 +1,85 @@
+package munsell
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// resetChips restores the package's chip table after a test that calls
+// SetRenotationChips, so later tests still see the built-in placeholder
+// table.
+func resetChips(t *testing.T) {
+	t.Helper()
+	saved := munsellChips
+	t.Cleanup(func() { munsellChips = saved })
+}
+
+func TestParseRenotationData(t *testing.T) {
+	const data = `H V C x y Y
+5R 4 14 0.5130 0.3190 12.00
+5R 4 14 0.5190 0.3220 12.00
+`
+	chips, err := ParseRenotationData(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ParseRenotationData: %v", err)
+	}
+	if len(chips) != 2 {
+		t.Fatalf("got %d chips, want 2", len(chips))
+	}
+	want := RenotationChip{HueLetter: "R", HuePrefix: 5, Value: 4, Chroma: 14, X: 0.5130, Y: 0.3190, LargeY: 12.00}
+	if chips[0] != want {
+		t.Errorf("chips[0] = %+v, want %+v", chips[0], want)
+	}
+}
+
+func TestParseRenotationData_FirstLineAlwaysDiscardedAsHeader(t *testing.T) {
+	// The first line is always treated as a header and discarded, even if
+	// it looks like a data row: a file with no real header loses its would-
+	// be first data row rather than silently guessing.
+	const data = `5R 4 14 0.5130 0.3190 12.00`
+	if _, err := ParseRenotationData(strings.NewReader(data)); err == nil {
+		t.Fatal("expected an error since the only row was consumed as a header, got nil")
+	}
+}
+
+func TestParseRenotationData_BadHue(t *testing.T) {
+	const data = "H V C x y Y\nXX 4 14 0.5130 0.3190 12.00\n"
+	if _, err := ParseRenotationData(strings.NewReader(data)); err == nil {
+		t.Fatal("expected an error for an unknown hue family, got nil")
+	}
+}
+
+func TestParseRenotationData_WrongFieldCount(t *testing.T) {
+	const data = "H V C x y Y\n5R 4 14 0.5130 0.3190\n"
+	if _, err := ParseRenotationData(strings.NewReader(data)); err == nil {
+		t.Fatal("expected an error for a short row, got nil")
+	}
+}
+
+func TestSetRenotationChips(t *testing.T) {
+	resetChips(t)
+	if err := SetRenotationChips([]RenotationChip{
+		{HueLetter: "R", HuePrefix: 5, Value: 4, Chroma: 14, X: 0.5130, Y: 0.3190, LargeY: 12.00},
+	}); err != nil {
+		t.Fatalf("SetRenotationChips: %v", err)
+	}
+	if len(munsellChips) != 1 {
+		t.Fatalf("len(munsellChips) = %d, want 1", len(munsellChips))
+	}
+	got := munsellChips[0]
+	if got.hueLetter != "R" || got.huePrefix != 5 || got.value != 4 || got.chroma != 14 {
+		t.Errorf("munsellChips[0] = %+v, want hue R 5, value 4, chroma 14", got)
+	}
+}
+
+func TestSetRenotationChips_Empty(t *testing.T) {
+	resetChips(t)
+	before := munsellChips
+	if err := SetRenotationChips(nil); !errors.Is(err, ErrNoChips) {
+		t.Errorf("SetRenotationChips(nil) error = %v, want ErrNoChips", err)
+	}
+	if len(munsellChips) != len(before) {
+		t.Errorf("SetRenotationChips(nil) modified the chip table; len = %d, want unchanged %d", len(munsellChips), len(before))
+	}
+}
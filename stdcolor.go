@@ -0,0 +1,59 @@
+package munsell
+
+import "image/color"
+
+// RGBA implements image/color.Color, so RGBComponents can be used anywhere
+// the standard library or an image-processing pipeline expects a
+// color.Color. Alpha is always fully opaque; see RGBAComponents for a
+// variant that carries its own alpha channel.
+func (rgb RGBComponents) RGBA() (r, g, b, a uint32) {
+	r = uint32(rgb.Red) | uint32(rgb.Red)<<8
+	g = uint32(rgb.Green) | uint32(rgb.Green)<<8
+	b = uint32(rgb.Blue) | uint32(rgb.Blue)<<8
+	a = 0xffff
+	return r, g, b, a
+}
+
+// RGBAComponents is RGBComponents with an explicit alpha channel, for
+// callers that need to round-trip the 8-hex-digit (#RRGGBBAA) form or a
+// color.Color whose alpha isn't fully opaque.
+type RGBAComponents struct {
+	Red, Green, Blue, Alpha uint8
+}
+
+// RGBA implements image/color.Color. The individual channels are
+// alpha-premultiplied, per the color.Color contract.
+func (rgb RGBAComponents) RGBA() (r, g, b, a uint32) {
+	a = uint32(rgb.Alpha) | uint32(rgb.Alpha)<<8
+	r = (uint32(rgb.Red) | uint32(rgb.Red)<<8) * a / 0xffff
+	g = (uint32(rgb.Green) | uint32(rgb.Green)<<8) * a / 0xffff
+	b = (uint32(rgb.Blue) | uint32(rgb.Blue)<<8) * a / 0xffff
+	return r, g, b, a
+}
+
+// FromStdColor converts any image/color.Color to RGBComponents, discarding
+// alpha and downscaling each 16-bit channel back to 8 bits.
+func FromStdColor(c color.Color) RGBComponents {
+	r, g, b, _ := c.RGBA()
+	return RGBComponents{
+		Red:   uint8(r >> 8),
+		Green: uint8(g >> 8),
+		Blue:  uint8(b >> 8),
+	}
+}
+
+// RGBAFromStdColor converts any image/color.Color to RGBAComponents,
+// un-premultiplying alpha and downscaling each 16-bit channel back to 8
+// bits.
+func RGBAFromStdColor(c color.Color) RGBAComponents {
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return RGBAComponents{}
+	}
+	return RGBAComponents{
+		Red:   uint8(r * 0xffff / a >> 8),
+		Green: uint8(g * 0xffff / a >> 8),
+		Blue:  uint8(b * 0xffff / a >> 8),
+		Alpha: uint8(a >> 8),
+	}
+}
@@ -0,0 +1,45 @@
+package munsell
+
+import "testing"
+
+func TestRGBComponents_RGBA_OpaqueRoundTrip(t *testing.T) {
+	orig := RGBComponents{Red: 10, Green: 128, Blue: 255}
+	got := FromStdColor(orig)
+	if got != orig {
+		t.Errorf("FromStdColor(orig.RGBA()) = %+v, want %+v", got, orig)
+	}
+}
+
+func TestRGBAComponents_RGBA_AlphaZero(t *testing.T) {
+	transparent := RGBAComponents{Red: 200, Green: 100, Blue: 50, Alpha: 0}
+	r, g, b, a := transparent.RGBA()
+	if r != 0 || g != 0 || b != 0 || a != 0 {
+		t.Errorf("RGBA() for alpha=0 = (%d,%d,%d,%d), want all zero (premultiplied)", r, g, b, a)
+	}
+	if got := RGBAFromStdColor(transparent); got != (RGBAComponents{}) {
+		t.Errorf("RGBAFromStdColor of a fully transparent color.Color = %+v, want zero value", got)
+	}
+}
+
+func TestRGBAComponents_RGBA_OpaqueRoundTrip(t *testing.T) {
+	orig := RGBAComponents{Red: 10, Green: 128, Blue: 255, Alpha: 255}
+	got := RGBAFromStdColor(orig)
+	if got != orig {
+		t.Errorf("RGBAFromStdColor(orig.RGBA()) = %+v, want %+v", got, orig)
+	}
+}
+
+func TestRGBAComponents_RGBA_PartialTransparencyRoundTrip(t *testing.T) {
+	orig := RGBAComponents{Red: 200, Green: 100, Blue: 50, Alpha: 128}
+	got := RGBAFromStdColor(orig)
+	if got.Alpha != orig.Alpha {
+		t.Errorf("Alpha round-trip = %d, want %d", got.Alpha, orig.Alpha)
+	}
+	const tolerance = 1
+	for _, pair := range [][2]uint8{{got.Red, orig.Red}, {got.Green, orig.Green}, {got.Blue, orig.Blue}} {
+		diff := int(pair[0]) - int(pair[1])
+		if diff < -tolerance || diff > tolerance {
+			t.Errorf("channel round-trip = %d, want %d +/- %d", pair[0], pair[1], tolerance)
+		}
+	}
+}